@@ -0,0 +1,27 @@
+// Package negligible implements the negligibility threshold shared by the
+// matrix and vectors packages, which expose its Tolerance type under their own
+// names.
+package negligible
+
+import "math"
+
+// Tolerance is an absolute and a relative threshold. The exported aliases
+// vectors.Tolerance and matrix.Tolerance document how each package applies it.
+type Tolerance struct {
+	Abs float64 // Absolute threshold
+	Rel float64 // Threshold relative to the scale of the input
+}
+
+// Threshold returns max(Abs, Rel·scale) for the first tolerance in tol. An
+// empty tol or the zero Tolerance selects defaultRel·scale. Negative fields
+// count as zero.
+func Threshold(tol []Tolerance, defaultRel, scale float64) float64 {
+	var t Tolerance
+	if len(tol) > 0 {
+		t = tol[0]
+	}
+	if t.Abs <= 0 && t.Rel <= 0 {
+		return defaultRel * scale
+	}
+	return math.Max(max(t.Abs, 0), max(t.Rel, 0)*scale)
+}
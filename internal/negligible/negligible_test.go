@@ -0,0 +1,27 @@
+package negligible
+
+import (
+	"math"
+	"testing"
+)
+
+func TestThreshold(t *testing.T) {
+	tests := []struct {
+		name     string
+		tol      []Tolerance
+		expected float64
+	}{
+		{"default", nil, 1e-15 * 10},
+		{"zero tolerance", []Tolerance{{}}, 1e-15 * 10},
+		{"relative", []Tolerance{{Rel: 1e-3}}, 1e-2},
+		{"absolute", []Tolerance{{Abs: 5}}, 5},
+		{"larger of both", []Tolerance{{Abs: 1e-6, Rel: 1e-3}}, 1e-2},
+		{"negative ignored", []Tolerance{{Abs: -1, Rel: 1e-3}}, 1e-2},
+		{"first used", []Tolerance{{Abs: 1}, {Abs: 2}}, 1},
+	}
+	for _, tt := range tests {
+		if got := Threshold(tt.tol, 1e-15, 10); math.Abs(got-tt.expected) > 1e-12*tt.expected {
+			t.Errorf("%s: expected %g, got %g", tt.name, tt.expected, got)
+		}
+	}
+}
@@ -0,0 +1,117 @@
+package iterative
+
+import (
+	"slices"
+
+	"github.com/rickykimani/linalg/vectors"
+)
+
+// BiCGSTAB solves A·x = b with the stabilized biconjugate gradient method.
+//
+// Parameters:
+//   - a: Square operator, which need not be symmetric
+//   - b: Right-hand side vector of type Vector[E] where E is int or float64
+//   - s: Solver settings, or nil for the defaults
+//
+// Returns:
+//   - Result: The approximate solution with its iteration count, final
+//     residual and convergence history
+//   - error: Returns error if the inputs are invalid, the operator fails, or the
+//     method breaks down (ErrBreakdown)
+//
+// BiCGSTAB uses short recurrences like CG, so its storage does not grow with
+// the iteration count as GMRES's does, at the cost of two products with A per
+// iteration and a residual that does not decrease monotonically. On rare
+// occasions one of its inner products vanishes and the method cannot
+// continue; the partial result is returned with ErrBreakdown, and GMRES is the
+// usual fallback. A preconditioner is applied from the right, as in GMRES.
+//
+// Failing to converge within MaxIter is not an error: Converged reports false
+// and the last approximation is returned.
+func BiCGSTAB[E int | float64](a Operator, b vectors.Vector[E], s *Settings) (Result, error) {
+	sv, err := newSolve(a, b, s)
+	if err != nil {
+		return Result{}, err
+	}
+
+	x := sv.res.X
+	r, err := sv.residual()
+	if err != nil {
+		return Result{}, err
+	}
+	if sv.record(sv.relative(norm(r))) {
+		return sv.finish()
+	}
+
+	rHat := slices.Clone(r) // Shadow residual
+	p := make(vectors.Vector[float64], sv.n)
+	v := make(vectors.Vector[float64], sv.n)
+	sVec := make(vectors.Vector[float64], sv.n)
+	rho, alpha, omega := 1.0, 1.0, 1.0
+
+	for {
+		rhoNew := dot(rHat, r)
+		if rhoNew == 0 {
+			return sv.breakdown()
+		}
+
+		beta := (rhoNew / rho) * (alpha / omega)
+		for i := range p {
+			p[i] = r[i] + beta*(p[i]-omega*v[i])
+		}
+
+		pHat, err := sv.apply(p)
+		if err != nil {
+			return sv.res, err
+		}
+		v, err = sv.mul(pHat)
+		if err != nil {
+			return sv.res, err
+		}
+		den := dot(rHat, v)
+		if den == 0 {
+			return sv.breakdown()
+		}
+		alpha = rhoNew / den
+
+		for i := range sVec {
+			sVec[i] = r[i] - alpha*v[i]
+		}
+		if rel := sv.relative(norm(sVec)); rel <= sv.tol {
+			// Converged half way through the iteration
+			axpy(alpha, pHat, x)
+			sv.step(rel)
+			break
+		}
+
+		sHat, err := sv.apply(sVec)
+		if err != nil {
+			return sv.res, err
+		}
+		t, err := sv.mul(sHat)
+		if err != nil {
+			return sv.res, err
+		}
+		tt := dot(t, t)
+		if tt == 0 {
+			return sv.breakdown()
+		}
+		omega = dot(t, sVec) / tt
+
+		axpy(alpha, pHat, x)
+		axpy(omega, sHat, x)
+		for i := range r {
+			r[i] = sVec[i] - omega*t[i]
+		}
+		rho = rhoNew
+
+		if sv.step(sv.relative(norm(r))) {
+			break
+		}
+		if omega == 0 {
+			return sv.breakdown()
+		}
+	}
+
+	return sv.finish()
+}
@@ -0,0 +1,47 @@
+package iterative
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/rickykimani/linalg/matrix"
+	"github.com/rickykimani/linalg/vectors"
+)
+
+func TestBiCGSTAB_IntRHS(t *testing.T) {
+	a, _ := FromMatrix(matrix.Matrix[int]{{3, 1, 0}, {-1, 4, 1}, {0, 2, 5}})
+	res, err := BiCGSTAB(a, vectors.Vector[int]{4, 4, 7}, &Settings{Tol: 1e-12})
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkSolution(t, res, vectors.Vector[float64]{1, 1, 1}, 1e-9)
+}
+
+func TestBiCGSTAB_Breakdown(t *testing.T) {
+	// For this rotation the shadow residual is orthogonal to A·r₀, so the
+	// first step divides by zero
+	a, _ := FromMatrix(matrix.Matrix[int]{{0, 1}, {-1, 0}})
+	res, err := BiCGSTAB(a, vectors.Vector[int]{1, 0}, nil)
+	if !errors.Is(err, ErrBreakdown) {
+		t.Fatalf("expected ErrBreakdown, got %v", err)
+	}
+	if res.Converged {
+		t.Error("a broken down solve should not report convergence")
+	}
+
+	// GMRES handles the same system
+	res, err = GMRES(a, vectors.Vector[int]{1, 0}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkSolution(t, res, vectors.Vector[float64]{0, 1}, 1e-10)
+}
+
+func BenchmarkBiCGSTAB(b *testing.B) {
+	a := convectionDiffusion(500, 0.5)
+	_, rhs := rhsFor(b, a, 500)
+	b.ResetTimer()
+	for b.Loop() {
+		BiCGSTAB(a, rhs, nil)
+	}
+}
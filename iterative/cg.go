@@ -0,0 +1,92 @@
+package iterative
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/rickykimani/linalg/matrix"
+	"github.com/rickykimani/linalg/vectors"
+)
+
+// CG solves A·x = b with the conjugate gradient method.
+//
+// Parameters:
+//   - a: Symmetric positive-definite operator
+//   - b: Right-hand side vector of type Vector[E] where E is int or float64
+//   - s: Solver settings, or nil for the defaults
+//
+// Returns:
+//   - Result: The approximate solution with its iteration count, final
+//     residual and convergence history
+//   - error: Returns error if the inputs are invalid, the operator fails, or a
+//     direction of non-positive curvature shows that A is not positive definite
+//     (matching matrix.ErrNotPositiveDefinite)
+//
+// CG minimizes the A-norm of the error over a growing Krylov subspace using
+// short recurrences, so it needs only four vectors of storage and one product
+// with A per iteration. In exact arithmetic it terminates in at most n
+// iterations; in practice the number of iterations grows with the square root
+// of the condition number of A. A preconditioner M, which must itself be
+// symmetric positive definite, replaces that by the condition number of M⁻¹·A.
+//
+// Failing to converge within MaxIter is not an error: Converged reports false
+// and the last approximation is returned.
+//
+// Example:
+//
+//	A, _ := sparse.FromMatrix(matrix.Matrix[int]{{4, 1}, {1, 3}})
+//	res, _ := CG(A, vectors.Vector[int]{1, 2}, nil)  // res.X ≈ [1/11, 7/11]
+func CG[E int | float64](a Operator, b vectors.Vector[E], s *Settings) (Result, error) {
+	sv, err := newSolve(a, b, s)
+	if err != nil {
+		return Result{}, err
+	}
+
+	x := sv.res.X
+	r, err := sv.residual()
+	if err != nil {
+		return Result{}, err
+	}
+	if sv.record(sv.relative(norm(r))) {
+		return sv.finish()
+	}
+	z, err := sv.apply(r)
+	if err != nil {
+		return sv.res, err
+	}
+	rz := dot(r, z)
+
+	p := slices.Clone(z)
+	for {
+		ap, err := sv.mul(p)
+		if err != nil {
+			return sv.res, err
+		}
+
+		pap := dot(p, ap)
+		if !(pap > 0) {
+			res, _ := sv.finish()
+			return res, fmt.Errorf("%w: pᵀ·A·p = %g at iteration %d", matrix.ErrNotPositiveDefinite, pap, sv.res.Iterations+1)
+		}
+
+		alpha := rz / pap
+		axpy(alpha, p, x)
+		axpy(-alpha, ap, r)
+
+		if sv.step(sv.relative(norm(r))) {
+			break
+		}
+
+		if z, err = sv.apply(r); err != nil {
+			return sv.res, err
+		}
+		rzNew := dot(r, z)
+		beta := rzNew / rz
+		for i := range p {
+			p[i] = z[i] + beta*p[i]
+		}
+		rz = rzNew
+	}
+
+	return sv.finish()
+}
@@ -0,0 +1,57 @@
+package iterative
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/rickykimani/linalg/matrix"
+	"github.com/rickykimani/linalg/vectors"
+)
+
+func TestCG(t *testing.T) {
+	a, _ := FromMatrix(matrix.Matrix[int]{{4, 1}, {1, 3}})
+	res, err := CG(a, vectors.Vector[int]{1, 2}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkSolution(t, res, vectors.Vector[float64]{1.0 / 11, 7.0 / 11}, 1e-10)
+	// CG terminates in at most n steps in exact arithmetic
+	if res.Iterations > 2 {
+		t.Errorf("expected at most 2 iterations, got %d", res.Iterations)
+	}
+}
+
+func TestCG_NotPositiveDefinite(t *testing.T) {
+	a, _ := FromMatrix(matrix.Matrix[int]{{1, 0}, {0, -1}})
+	res, err := CG(a, vectors.Vector[int]{1, 1}, nil)
+	if !errors.Is(err, matrix.ErrNotPositiveDefinite) {
+		t.Fatalf("expected ErrNotPositiveDefinite, got %v", err)
+	}
+	if res.X == nil {
+		t.Error("expected the partial result to be returned")
+	}
+}
+
+func TestCG_ResidualDecreases(t *testing.T) {
+	a := laplacian2D(20)
+	_, b := rhsFor(t, a, 400)
+	res, err := CG(a, b, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !res.Converged {
+		t.Fatalf("did not converge: residual %g", res.Residual)
+	}
+	if res.Residual > 1e-8 || res.History[len(res.History)-1] > 1e-8 {
+		t.Errorf("final residual %g exceeds the default tolerance", res.Residual)
+	}
+}
+
+func BenchmarkCG(b *testing.B) {
+	a := laplacian2D(50)
+	_, rhs := rhsFor(b, a, 2500)
+	b.ResetTimer()
+	for b.Loop() {
+		CG(a, rhs, nil)
+	}
+}
@@ -0,0 +1,153 @@
+package iterative
+
+import (
+	"math"
+
+	"github.com/rickykimani/linalg/vectors"
+)
+
+// GMRES solves A·x = b with the restarted generalized minimal residual method.
+//
+// Parameters:
+//   - a: Square operator, which need not be symmetric
+//   - b: Right-hand side vector of type Vector[E] where E is int or float64
+//   - s: Solver settings, or nil for the defaults; Restart sets the cycle length
+//
+// Returns:
+//   - Result: The approximate solution with its iteration count, final
+//     residual and convergence history
+//   - error: Returns error if the inputs are invalid, the operator fails, or the
+//     method breaks down on a singular operator (ErrBreakdown)
+//
+// Each cycle builds an orthonormal basis of the Krylov subspace with the
+// Arnoldi process and picks the x in it that minimizes ‖b - A·x‖, so the
+// residual never increases. Givens rotations update the least-squares problem
+// incrementally, which makes the residual norm available after every inner
+// iteration at no extra cost. Storage and work per iteration grow with the
+// cycle length, so the basis is discarded and the method restarted from the
+// current x every Restart iterations.
+//
+// A preconditioner M is applied from the right: GMRES minimizes the residual
+// of A·M⁻¹·u = b and sets x = M⁻¹·u, so the reported residuals are those of
+// the original system.
+//
+// Failing to converge within MaxIter is not an error: Converged reports false
+// and the last approximation is returned.
+func GMRES[E int | float64](a Operator, b vectors.Vector[E], s *Settings) (Result, error) {
+	sv, err := newSolve(a, b, s)
+	if err != nil {
+		return Result{}, err
+	}
+
+	x := sv.res.X
+	m := sv.restart
+	basis := make([]vectors.Vector[float64], m+1)
+	h := make([][]float64, m+1) // Hessenberg matrix, reduced to triangular form in place
+	for i := range h {
+		h[i] = make([]float64, m)
+	}
+	cs := make([]float64, m)
+	sn := make([]float64, m)
+	g := make([]float64, m+1)
+
+	for cycle := 0; ; cycle++ {
+		r, err := sv.residual()
+		if err != nil {
+			return sv.res, err
+		}
+		beta := norm(r)
+		if cycle == 0 && sv.record(sv.relative(beta)) {
+			break
+		}
+		if beta == 0 {
+			break
+		}
+
+		// Arnoldi process with modified Gram-Schmidt
+		basis[0] = r
+		for i := range r {
+			r[i] /= beta
+		}
+		clear(g)
+		g[0] = beta
+
+		k, done := 0, false
+		for j := range m {
+			z, err := sv.apply(basis[j])
+			if err != nil {
+				return sv.res, err
+			}
+			w, err := sv.mul(z)
+			if err != nil {
+				return sv.res, err
+			}
+			for i := 0; i <= j; i++ {
+				h[i][j] = dot(w, basis[i])
+				axpy(-h[i][j], basis[i], w)
+			}
+			hNext := norm(w)
+
+			// Apply the previous rotations to the new column, then eliminate h[j+1][j]
+			for i := range j {
+				h[i][j], h[i+1][j] = cs[i]*h[i][j]+sn[i]*h[i+1][j], -sn[i]*h[i][j]+cs[i]*h[i+1][j]
+			}
+			d := math.Hypot(h[j][j], hNext)
+			if d == 0 {
+				// A maps the basis into a lower-dimensional space: A is singular
+				if err := sv.update(x, basis, h, g, k); err != nil {
+					return sv.res, err
+				}
+				return sv.breakdown()
+			}
+			cs[j], sn[j] = h[j][j]/d, hNext/d
+			h[j][j] = d
+			g[j+1] = -sn[j] * g[j]
+			g[j] *= cs[j]
+			k = j + 1
+
+			// A zero hNext means the Krylov subspace is invariant and the
+			// least-squares solution is exact
+			if sv.step(sv.relative(math.Abs(g[j+1]))) || hNext == 0 {
+				done = true
+				break
+			}
+
+			for i := range w {
+				w[i] /= hNext
+			}
+			basis[j+1] = w
+		}
+
+		if err := sv.update(x, basis, h, g, k); err != nil {
+			return sv.res, err
+		}
+		if done {
+			break
+		}
+	}
+
+	return sv.finish()
+}
+
+// update adds M⁻¹·V·y to x, where V holds the first k basis vectors and y
+// solves the triangular least-squares system H·y = g.
+func (sv *solve) update(x vectors.Vector[float64], basis []vectors.Vector[float64], h [][]float64, g []float64, k int) error {
+	y := make([]float64, k)
+	for i := k - 1; i >= 0; i-- {
+		s := g[i]
+		for j := i + 1; j < k; j++ {
+			s -= h[i][j] * y[j]
+		}
+		y[i] = s / h[i][i]
+	}
+	u := make(vectors.Vector[float64], len(x))
+	for j := range k {
+		axpy(y[j], basis[j], u)
+	}
+	u, err := sv.apply(u)
+	if err != nil {
+		return err
+	}
+	axpy(1, u, x)
+	return nil
+}
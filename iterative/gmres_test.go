@@ -0,0 +1,58 @@
+package iterative
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/rickykimani/linalg/matrix"
+	"github.com/rickykimani/linalg/vectors"
+)
+
+func TestGMRES_Restart(t *testing.T) {
+	a := convectionDiffusion(200, 1)
+	want, b := rhsFor(t, a, 200)
+
+	for _, restart := range []int{5, 20, 200} {
+		res, err := GMRES(a, b, &Settings{Tol: 1e-10, Restart: restart, MaxIter: 5000})
+		if err != nil {
+			t.Fatalf("restart %d: %v", restart, err)
+		}
+		checkSolution(t, res, want, 1e-6)
+
+		// The minimal residual property makes the history non-increasing
+		for i := 1; i < len(res.History); i++ {
+			if res.History[i] > res.History[i-1]*(1+1e-10) {
+				t.Fatalf("restart %d: residual increased at iteration %d: %g > %g", restart, i, res.History[i], res.History[i-1])
+			}
+		}
+	}
+}
+
+func TestGMRES_FullConvergesInNSteps(t *testing.T) {
+	a, _ := FromMatrix(matrix.Matrix[int]{{1, 2, 0}, {0, 1, 3}, {4, 0, 1}})
+	res, err := GMRES(a, vectors.Vector[int]{1, 2, 3}, &Settings{Tol: 1e-12})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !res.Converged || res.Iterations > 3 {
+		t.Errorf("expected convergence in at most 3 iterations, got %d (residual %g)", res.Iterations, res.Residual)
+	}
+}
+
+func TestGMRES_Singular(t *testing.T) {
+	// b lies outside the range of A, so no iterate can reduce the residual to zero
+	a, _ := FromMatrix(matrix.Matrix[int]{{0, 1}, {0, 0}})
+	_, err := GMRES(a, vectors.Vector[int]{0, 1}, nil)
+	if !errors.Is(err, ErrBreakdown) {
+		t.Fatalf("expected ErrBreakdown, got %v", err)
+	}
+}
+
+func BenchmarkGMRES(b *testing.B) {
+	a := convectionDiffusion(500, 0.5)
+	_, rhs := rhsFor(b, a, 500)
+	b.ResetTimer()
+	for b.Loop() {
+		GMRES(a, rhs, nil)
+	}
+}
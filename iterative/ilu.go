@@ -0,0 +1,236 @@
+package iterative
+
+import (
+	"fmt"
+	"math"
+	"slices"
+
+	"github.com/rickykimani/linalg/matrix"
+	"github.com/rickykimani/linalg/sparse"
+	"github.com/rickykimani/linalg/vectors"
+)
+
+// ILU0 is the incomplete LU preconditioner with zero fill-in, M = L·U.
+//
+// L and U are computed by Gaussian elimination restricted to the nonzero
+// pattern of A: every update that would create a new nonzero is dropped.
+// They therefore take no more storage than A itself, while M usually
+// approximates A far better than the diagonal does.
+type ILU0 struct {
+	indptr  []int
+	indices []int
+	data    []float64 // Strictly lower part holds L (unit diagonal implied), the rest holds U
+	diag    []int
+}
+
+// NewILU0 computes the ILU(0) factorization of A.
+//
+// Parameters:
+//   - a: A square sparse matrix, or a dense matrix wrapped by FromMatrix, whose
+//     diagonal elements are all stored
+//
+// Returns:
+//   - *ILU0: The preconditioner
+//   - error: Returns error if A is not an explicit square matrix, has a zero
+//     diagonal element, or elimination produces a zero pivot (matching
+//     matrix.ErrSingular)
+//
+// For a dense matrix the pattern is every nonzero element, so the
+// factorization approaches a complete LU decomposition.
+//
+// Time complexity: O(Σ nnz(row)²) in the worst case, typically O(nnz).
+func NewILU0(a Operator) (*ILU0, error) {
+	c, err := explicit(a)
+	if err != nil {
+		return nil, err
+	}
+	diag, err := diagonalIndex(c)
+	if err != nil {
+		return nil, err
+	}
+
+	indptr, indices, data := c.RawData()
+	n := len(diag)
+	f := &ILU0{indptr: indptr, indices: indices, data: slices.Clone(data), diag: diag}
+
+	// IKJ elimination; pos maps a column of the current row to its position
+	pos := make([]int, n)
+	for i := range pos {
+		pos[i] = -1
+	}
+	for i := range n {
+		for k := indptr[i]; k < indptr[i+1]; k++ {
+			pos[indices[k]] = k
+		}
+		for k := indptr[i]; k < diag[i]; k++ {
+			col := indices[k]
+			f.data[k] /= f.data[diag[col]]
+			lik := f.data[k]
+			for kk := diag[col] + 1; kk < indptr[col+1]; kk++ {
+				if p := pos[indices[kk]]; p >= 0 {
+					f.data[p] -= lik * f.data[kk]
+				}
+			}
+		}
+		if d := f.data[diag[i]]; d == 0 || math.IsNaN(d) {
+			return nil, fmt.Errorf("zero pivot at row %d: %w", i, matrix.ErrSingular)
+		}
+		for k := indptr[i]; k < indptr[i+1]; k++ {
+			pos[indices[k]] = -1
+		}
+	}
+	return f, nil
+}
+
+// Apply returns z = U⁻¹·L⁻¹·r by forward and backward substitution.
+func (f *ILU0) Apply(r vectors.Vector[float64]) (vectors.Vector[float64], error) {
+	n := len(f.diag)
+	if err := checkApply(r, n); err != nil {
+		return nil, err
+	}
+
+	z := make(vectors.Vector[float64], n)
+	for i := range n {
+		s := r[i]
+		for k := f.indptr[i]; k < f.diag[i]; k++ {
+			s -= f.data[k] * z[f.indices[k]]
+		}
+		z[i] = s
+	}
+	for i := n - 1; i >= 0; i-- {
+		s := z[i]
+		for k := f.diag[i] + 1; k < f.indptr[i+1]; k++ {
+			s -= f.data[k] * z[f.indices[k]]
+		}
+		z[i] = s / f.data[f.diag[i]]
+	}
+	return z, nil
+}
+
+// IC0 is the incomplete Cholesky preconditioner with zero fill-in, M = L·Lᵀ.
+//
+// It is the symmetric counterpart of ILU0: L has the nonzero pattern of the
+// lower triangle of A. M is symmetric positive definite, which makes IC0 the
+// standard preconditioner for CG.
+type IC0 struct {
+	indptr  []int // Row storage of L, with the diagonal last in each row
+	indices []int
+	data    []float64
+}
+
+// NewIC0 computes the IC(0) factorization of a symmetric positive-definite A.
+//
+// Parameters:
+//   - a: A symmetric square sparse matrix, or a dense matrix wrapped by FromMatrix
+//
+// Returns:
+//   - *IC0: The preconditioner
+//   - error: Returns error if A is not an explicit square matrix, is not
+//     symmetric (matching matrix.ErrNotSymmetric), or a pivot is not positive
+//     (a *matrix.NotPositiveDefiniteError)
+//
+// The incomplete factorization can break down even for some positive-definite
+// matrices, although it always exists for M-matrices such as discretized
+// Laplacians. Use ILU0 or a diagonal shift of A when it does.
+func NewIC0(a Operator) (*IC0, error) {
+	c, err := explicit(a)
+	if err != nil {
+		return nil, err
+	}
+	if !symmetricCSR(c) {
+		return nil, matrix.ErrNotSymmetric
+	}
+
+	// Extract the lower triangle, whose rows end at the diagonal
+	indptr, indices, data := c.RawData()
+	n := len(indptr) - 1
+	f := &IC0{indptr: make([]int, n+1)}
+	for i := range n {
+		for k := indptr[i]; k < indptr[i+1] && indices[k] <= i; k++ {
+			f.indices = append(f.indices, indices[k])
+			f.data = append(f.data, data[k])
+		}
+		f.indptr[i+1] = len(f.indices)
+		if last := f.indptr[i+1] - 1; last < f.indptr[i] || f.indices[last] != i {
+			return nil, &matrix.NotPositiveDefiniteError{Pivot: i, Value: 0}
+		}
+	}
+
+	for i := range n {
+		for k := f.indptr[i]; k < f.indptr[i+1]; k++ {
+			j := f.indices[k]
+
+			// Σ L[i][m]·L[j][m] over the shared pattern with m < j
+			s := f.data[k]
+			p, q := f.indptr[i], f.indptr[j]
+			for p < k && q < f.indptr[j+1]-1 {
+				switch {
+				case f.indices[p] < f.indices[q]:
+					p++
+				case f.indices[p] > f.indices[q]:
+					q++
+				default:
+					s -= f.data[p] * f.data[q]
+					p++
+					q++
+				}
+			}
+
+			if j < i {
+				f.data[k] = s / f.data[f.indptr[j+1]-1]
+				continue
+			}
+			if !(s > 0) {
+				return nil, &matrix.NotPositiveDefiniteError{Pivot: i, Value: s}
+			}
+			f.data[k] = math.Sqrt(s)
+		}
+	}
+	return f, nil
+}
+
+// symmetricCSR reports whether c equals its transpose to within a
+// relative tolerance of 1e-10 per element.
+func symmetricCSR(c *sparse.CSR) bool {
+	t := c.ToCSC() // Column storage of A is row storage of Aᵀ
+	ip, ix, d := c.RawData()
+	tp, tx, td := t.RawData()
+	if !slices.Equal(ip, tp) || !slices.Equal(ix, tx) {
+		return false
+	}
+	for k := range d {
+		if math.Abs(d[k]-td[k]) > 1e-10*max(1, math.Abs(d[k])) {
+			return false
+		}
+	}
+	return true
+}
+
+// Apply returns z = L⁻ᵀ·L⁻¹·r by forward and backward substitution.
+func (f *IC0) Apply(r vectors.Vector[float64]) (vectors.Vector[float64], error) {
+	n := len(f.indptr) - 1
+	if err := checkApply(r, n); err != nil {
+		return nil, err
+	}
+
+	// Solve L·y = r
+	z := make(vectors.Vector[float64], n)
+	for i := range n {
+		s := r[i]
+		last := f.indptr[i+1] - 1
+		for k := f.indptr[i]; k < last; k++ {
+			s -= f.data[k] * z[f.indices[k]]
+		}
+		z[i] = s / f.data[last]
+	}
+
+	// Solve Lᵀ·z = y, using the rows of L as the columns of Lᵀ
+	for i := n - 1; i >= 0; i-- {
+		last := f.indptr[i+1] - 1
+		z[i] /= f.data[last]
+		for k := f.indptr[i]; k < last; k++ {
+			z[f.indices[k]] -= f.data[k] * z[i]
+		}
+	}
+	return z, nil
+}
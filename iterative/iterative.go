@@ -0,0 +1,317 @@
+// Package iterative provides Krylov subspace and stationary solvers for large
+// linear systems A·x = b.
+//
+// Unlike the direct solvers in the matrix package, these methods never
+// factorize A. They only need to multiply vectors by it, so A can be a sparse
+// matrix, a dense matrix, or any matrix-free operator described by the
+// Operator interface. Each iteration costs one or two products with A.
+//
+// The available methods are CG for symmetric positive-definite systems, and
+// restarted GMRES and BiCGSTAB for general nonsymmetric systems. The classic
+// stationary methods JOR (damped Jacobi), GaussSeidel and SOR are simpler and
+// converge more slowly; they update one component at a time and so need an
+// explicit sparse or dense matrix rather than an arbitrary Operator.
+//
+// Ill-conditioned systems converge slowly, or not at all, without a
+// preconditioner. Jacobi, SSOR, ILU0 and IC0 are built from an explicit
+// sparse or dense matrix and passed to any method through Settings.Precond.
+// CG applies the preconditioner symmetrically; GMRES and BiCGSTAB apply it
+// from the right, so the residuals they report are those of the original
+// system.
+package iterative
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/rickykimani/linalg/matrix"
+	"github.com/rickykimani/linalg/vectors"
+)
+
+// ErrBreakdown is returned when a method cannot continue because a scalar it
+// divides by has become zero, which can happen for BiCGSTAB on some
+// nonsymmetric systems. The partial result is still returned.
+var ErrBreakdown = errors.New("iterative method broke down")
+
+// Operator is a linear operator that can multiply a vector.
+//
+// Every format in the sparse package implements Operator. Use FromMatrix for a
+// dense matrix.Matrix, or OperatorFunc to describe an operator by a function.
+type Operator interface {
+	// MulVec returns the product A·x.
+	MulVec(x vectors.Vector[float64]) (vectors.Vector[float64], error)
+}
+
+// OperatorFunc adapts a function to the Operator interface, which makes it
+// possible to solve systems whose matrix is never formed explicitly.
+type OperatorFunc func(x vectors.Vector[float64]) (vectors.Vector[float64], error)
+
+// MulVec calls f(x).
+func (f OperatorFunc) MulVec(x vectors.Vector[float64]) (vectors.Vector[float64], error) {
+	return f(x)
+}
+
+// denseOperator is the Operator for a dense matrix.
+type denseOperator struct {
+	m matrix.Matrix[float64]
+}
+
+func (d denseOperator) MulVec(x vectors.Vector[float64]) (vectors.Vector[float64], error) {
+	return matrix.MultiplyVector(d.m, x)
+}
+
+func (d denseOperator) Dims() (rows, cols int) {
+	return d.m.Rows(), d.m.Cols()
+}
+
+// FromMatrix wraps a dense matrix as an Operator.
+//
+// Parameters:
+//   - m: Input matrix of type matrix.Matrix[T] where T is int or float64
+//
+// Returns:
+//   - Operator: An operator that multiplies by a float64 copy of m
+//   - error: An error if the matrix is invalid or empty
+func FromMatrix[T int | float64](m matrix.Matrix[T]) (Operator, error) {
+	if err := m.Validate(); err != nil {
+		return nil, err
+	}
+	if len(m) == 0 {
+		return nil, matrix.ErrEmpty
+	}
+	mf, _ := matrix.NewMatrix(m)
+	return denseOperator{m: mf}, nil
+}
+
+// Settings controls an iterative solve. A nil *Settings uses the defaults for
+// every field, and zero-valued fields also take their defaults.
+type Settings struct {
+	// Tol is the relative residual tolerance: the solve has converged once
+	// ‖b - A·x‖ ≤ Tol·‖b‖. The default is 1e-8.
+	Tol float64
+
+	// MaxIter bounds the total number of iterations, counting the inner
+	// iterations of restarted GMRES. The default is 10·n, and at least 1000
+	// for the stationary methods.
+	MaxIter int
+
+	// Restart is the number of inner iterations between GMRES restarts,
+	// which bounds its memory use to Restart+1 vectors. The default is
+	// min(n, 30). Other methods ignore it.
+	Restart int
+
+	// Omega is the relaxation parameter of the stationary methods. SOR
+	// requires it in (0, 2), and JOR uses it as a damping factor in (0, 1].
+	// The default is 1. Other methods ignore it.
+	Omega float64
+
+	// StepTol, if positive, also stops the stationary methods once
+	// successive iterates satisfy ‖x_k - x_{k-1}‖ ≤ StepTol·‖x_k‖. A small
+	// step only suggests convergence, so Converged still reflects the
+	// residual alone. Other methods ignore it.
+	StepTol float64
+
+	// X0 is the initial guess. The default is the zero vector.
+	X0 vectors.Vector[float64]
+
+	// Precond, if set, is applied to accelerate convergence. CG requires it
+	// to be symmetric positive definite, as Jacobi, SSOR and IC0 are for an
+	// SPD matrix. The stationary methods ignore it.
+	Precond Preconditioner
+
+	// Callback, if set, is called after every iteration with the iteration
+	// number (starting at 1) and the current relative residual estimate.
+	// Returning false stops the solve early.
+	Callback func(iter int, residual float64) bool
+}
+
+// Result reports the outcome of an iterative solve.
+type Result struct {
+	X          vectors.Vector[float64] // Final approximation to the solution
+	Iterations int                     // Number of iterations performed
+	Residual   float64                 // Final relative residual ‖b - A·x‖/‖b‖
+	Converged  bool                    // Whether Residual reached the tolerance
+	History    []float64               // Relative residual before the first iteration and after each one
+}
+
+// solve holds the state shared by all methods during one solve.
+type solve struct {
+	a       Operator
+	n       int
+	b       vectors.Vector[float64]
+	bnorm   float64
+	tol     float64
+	maxIter int
+	restart int
+	precond Preconditioner
+	cb      func(int, float64) bool
+	res     Result
+}
+
+// newSolve validates the inputs, applies the defaults and initializes x.
+func newSolve[E int | float64](a Operator, b vectors.Vector[E], s *Settings) (*solve, error) {
+	if a == nil {
+		return nil, fmt.Errorf("operator cannot be nil: %w", matrix.ErrInvalidArgument)
+	}
+	n := len(b)
+	if n == 0 {
+		return nil, vectors.ErrEmpty
+	}
+	if d, ok := a.(interface{ Dims() (int, int) }); ok {
+		if rows, cols := d.Dims(); rows != cols || rows != n {
+			return nil, &matrix.DimensionError{Op: "solve", Shapes: [][2]int{{rows, cols}, {n, 1}}}
+		}
+	}
+
+	if s == nil {
+		s = &Settings{}
+	}
+	if s.Tol < 0 || s.StepTol < 0 || math.IsNaN(s.Tol) || math.IsNaN(s.StepTol) {
+		return nil, fmt.Errorf("tolerance cannot be negative: %w", matrix.ErrInvalidArgument)
+	}
+	if s.MaxIter < 0 || s.Restart < 0 {
+		return nil, fmt.Errorf("iteration limits cannot be negative: %w", matrix.ErrInvalidArgument)
+	}
+
+	sv := &solve{
+		a:       a,
+		n:       n,
+		b:       b.Copy(),
+		tol:     s.Tol,
+		maxIter: s.MaxIter,
+		restart: s.Restart,
+		precond: s.Precond,
+		cb:      s.Callback,
+	}
+	if sv.tol == 0 {
+		sv.tol = 1e-8
+	}
+	if sv.maxIter == 0 {
+		sv.maxIter = 10 * n
+	}
+	if sv.restart == 0 {
+		sv.restart = min(n, 30)
+	}
+	sv.bnorm = norm(sv.b)
+
+	sv.res.X = make(vectors.Vector[float64], n)
+	if s.X0 != nil {
+		if len(s.X0) != n {
+			return nil, fmt.Errorf("initial guess has %d elements, expected %d: %w", len(s.X0), n, matrix.ErrDimensionMismatch)
+		}
+		copy(sv.res.X, s.X0)
+	}
+	return sv, nil
+}
+
+// mul returns A·x, checking the length of the result.
+func (sv *solve) mul(x vectors.Vector[float64]) (vectors.Vector[float64], error) {
+	y, err := sv.a.MulVec(x)
+	if err != nil {
+		return nil, err
+	}
+	if len(y) != sv.n {
+		return nil, fmt.Errorf("operator returned %d elements, expected %d: %w", len(y), sv.n, matrix.ErrDimensionMismatch)
+	}
+	return y, nil
+}
+
+// apply returns M⁻¹·r, or r itself without a preconditioner.
+func (sv *solve) apply(r vectors.Vector[float64]) (vectors.Vector[float64], error) {
+	if sv.precond == nil {
+		return r, nil
+	}
+	z, err := sv.precond.Apply(r)
+	if err != nil {
+		return nil, err
+	}
+	if len(z) != sv.n {
+		return nil, fmt.Errorf("preconditioner returned %d elements, expected %d: %w", len(z), sv.n, matrix.ErrDimensionMismatch)
+	}
+	return z, nil
+}
+
+// residual returns r = b - A·x for the current x.
+func (sv *solve) residual() (vectors.Vector[float64], error) {
+	ax, err := sv.mul(sv.res.X)
+	if err != nil {
+		return nil, err
+	}
+	r := make(vectors.Vector[float64], sv.n)
+	for i := range r {
+		r[i] = sv.b[i] - ax[i]
+	}
+	return r, nil
+}
+
+// relative converts a residual norm to a residual relative to ‖b‖.
+func (sv *solve) relative(rnorm float64) float64 {
+	if sv.bnorm == 0 {
+		return rnorm
+	}
+	return rnorm / sv.bnorm
+}
+
+// record appends a relative residual to the history and reports whether the
+// solve has converged.
+func (sv *solve) record(rel float64) bool {
+	sv.res.Residual = rel
+	sv.res.History = append(sv.res.History, rel)
+	sv.res.Converged = rel <= sv.tol
+	return sv.res.Converged
+}
+
+// step records the residual after an iteration and reports whether the solve
+// should stop, because it converged, ran out of iterations or the callback
+// asked it to.
+func (sv *solve) step(rel float64) bool {
+	sv.res.Iterations++
+	converged := sv.record(rel)
+	stopped := sv.cb != nil && !sv.cb(sv.res.Iterations, rel)
+	return converged || stopped || sv.res.Iterations >= sv.maxIter
+}
+
+// finish recomputes the true residual of the final x, which the methods
+// otherwise only track through recurrences that drift from it in floating
+// point, and returns the result.
+func (sv *solve) finish() (Result, error) {
+	r, err := sv.residual()
+	if err != nil {
+		return sv.res, err
+	}
+	sv.res.Residual = sv.relative(norm(r))
+	sv.res.Converged = sv.res.Residual <= sv.tol
+	return sv.res, nil
+}
+
+// dot returns the inner product of x and y.
+func dot(x, y []float64) float64 {
+	s := 0.0
+	for i := range x {
+		s += x[i] * y[i]
+	}
+	return s
+}
+
+// norm returns the Euclidean norm of x.
+func norm(x []float64) float64 {
+	return math.Sqrt(dot(x, x))
+}
+
+// axpy computes y += alpha·x.
+func axpy(alpha float64, x, y []float64) {
+	for i := range x {
+		y[i] += alpha * x[i]
+	}
+}
+
+// breakdown finishes a solve that cannot continue. The error is ErrBreakdown
+// unless the current x happens to satisfy the tolerance already.
+func (sv *solve) breakdown() (Result, error) {
+	res, err := sv.finish()
+	if err == nil && !res.Converged {
+		err = ErrBreakdown
+	}
+	return res, err
+}
@@ -0,0 +1,288 @@
+package iterative
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/rickykimani/linalg/matrix"
+	"github.com/rickykimani/linalg/sparse"
+	"github.com/rickykimani/linalg/vectors"
+)
+
+// solver is the common signature of all methods for float64 right-hand sides.
+type solver func(a Operator, b vectors.Vector[float64], s *Settings) (Result, error)
+
+// laplacian2D returns the n²×n² five-point Laplacian on an n×n grid, a
+// standard symmetric positive-definite test matrix.
+func laplacian2D(n int) *sparse.CSR {
+	a, _ := sparse.NewCOO(n*n, n*n)
+	for i := range n {
+		for j := range n {
+			k := i*n + j
+			a.Add(k, k, 4)
+			if i > 0 {
+				a.Add(k, k-n, -1)
+			}
+			if i < n-1 {
+				a.Add(k, k+n, -1)
+			}
+			if j > 0 {
+				a.Add(k, k-1, -1)
+			}
+			if j < n-1 {
+				a.Add(k, k+1, -1)
+			}
+		}
+	}
+	return a.ToCSR()
+}
+
+// convectionDiffusion returns the n×n nonsymmetric tridiagonal matrix of a
+// 1D convection-diffusion problem with upwind differencing.
+func convectionDiffusion(n int, c float64) *sparse.CSR {
+	a, _ := sparse.NewCOO(n, n)
+	for i := range n {
+		a.Add(i, i, 2+c)
+		if i > 0 {
+			a.Add(i, i-1, -1-c)
+		}
+		if i < n-1 {
+			a.Add(i, i+1, -1)
+		}
+	}
+	return a.ToCSR()
+}
+
+// rhsFor returns b = A·x for a known solution x with x[i] = sin(i+1).
+func rhsFor(t testing.TB, a Operator, n int) (x, b vectors.Vector[float64]) {
+	t.Helper()
+	x = make(vectors.Vector[float64], n)
+	for i := range x {
+		x[i] = math.Sin(float64(i + 1))
+	}
+	b, err := a.MulVec(x)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return x, b
+}
+
+// checkSolution verifies a converged result against the known solution.
+func checkSolution(t *testing.T, res Result, want vectors.Vector[float64], tol float64) {
+	t.Helper()
+	if !res.Converged {
+		t.Fatalf("did not converge: residual %g after %d iterations", res.Residual, res.Iterations)
+	}
+	if len(res.History) != res.Iterations+1 {
+		t.Errorf("history has %d entries, expected %d", len(res.History), res.Iterations+1)
+	}
+	for i := range want {
+		if math.Abs(res.X[i]-want[i]) > tol {
+			t.Fatalf("x[%d] = %g, expected %g", i, res.X[i], want[i])
+		}
+	}
+}
+
+func TestSolvers(t *testing.T) {
+	spd := laplacian2D(10)
+	nonsym := convectionDiffusion(100, 0.5)
+	dense, _ := FromMatrix(matrix.Matrix[int]{{4, 1, 0}, {1, 3, -1}, {0, -1, 2}})
+
+	tests := []struct {
+		name  string
+		solve solver
+		a     Operator
+		n     int
+	}{
+		{"CG/laplacian", CG[float64], spd, 100},
+		{"CG/dense", CG[float64], dense, 3},
+		{"GMRES/laplacian", GMRES[float64], spd, 100},
+		{"GMRES/convection", GMRES[float64], nonsym, 100},
+		{"GMRES/dense", GMRES[float64], dense, 3},
+		{"BiCGSTAB/laplacian", BiCGSTAB[float64], spd, 100},
+		{"BiCGSTAB/convection", BiCGSTAB[float64], nonsym, 100},
+		{"BiCGSTAB/dense", BiCGSTAB[float64], dense, 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want, b := rhsFor(t, tt.a, tt.n)
+			res, err := tt.solve(tt.a, b, &Settings{Tol: 1e-10, MaxIter: 1000})
+			if err != nil {
+				t.Fatal(err)
+			}
+			checkSolution(t, res, want, 1e-7)
+		})
+	}
+}
+
+func TestSolvers_ZeroRHS(t *testing.T) {
+	a := laplacian2D(3)
+	for name, solve := range map[string]solver{"CG": CG[float64], "GMRES": GMRES[float64], "BiCGSTAB": BiCGSTAB[float64]} {
+		res, err := solve(a, make(vectors.Vector[float64], 9), nil)
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if !res.Converged || res.Iterations != 0 {
+			t.Errorf("%s: expected immediate convergence, got %d iterations", name, res.Iterations)
+		}
+		for _, v := range res.X {
+			if v != 0 {
+				t.Errorf("%s: expected zero solution, got %v", name, res.X)
+				break
+			}
+		}
+	}
+}
+
+func TestSolvers_InitialGuess(t *testing.T) {
+	a := laplacian2D(6)
+	want, b := rhsFor(t, a, 36)
+	for name, solve := range map[string]solver{"CG": CG[float64], "GMRES": GMRES[float64], "BiCGSTAB": BiCGSTAB[float64]} {
+		res, err := solve(a, b, &Settings{X0: want})
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if res.Iterations != 0 || !res.Converged {
+			t.Errorf("%s: exact initial guess took %d iterations", name, res.Iterations)
+		}
+	}
+}
+
+func TestSolvers_Callback(t *testing.T) {
+	a := laplacian2D(10)
+	_, b := rhsFor(t, a, 100)
+	for name, solve := range map[string]solver{"CG": CG[float64], "GMRES": GMRES[float64], "BiCGSTAB": BiCGSTAB[float64]} {
+		var calls []int
+		res, err := solve(a, b, &Settings{Callback: func(iter int, residual float64) bool {
+			calls = append(calls, iter)
+			return iter < 3
+		}})
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if res.Iterations != 3 || len(calls) != 3 || calls[2] != 3 {
+			t.Errorf("%s: expected to stop after 3 iterations, got %d (callbacks %v)", name, res.Iterations, calls)
+		}
+		if res.Converged {
+			t.Errorf("%s: should not have converged after 3 iterations", name)
+		}
+		if len(res.History) != 4 {
+			t.Errorf("%s: history has %d entries, expected 4", name, len(res.History))
+		}
+	}
+}
+
+func TestSolvers_MaxIter(t *testing.T) {
+	a := laplacian2D(10)
+	_, b := rhsFor(t, a, 100)
+	for name, solve := range map[string]solver{"CG": CG[float64], "GMRES": GMRES[float64], "BiCGSTAB": BiCGSTAB[float64]} {
+		res, err := solve(a, b, &Settings{MaxIter: 5})
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if res.Iterations != 5 || res.Converged {
+			t.Errorf("%s: expected 5 unconverged iterations, got %d (converged %v)", name, res.Iterations, res.Converged)
+		}
+		if res.Residual >= res.History[0] {
+			t.Errorf("%s: residual %g did not decrease from %g", name, res.Residual, res.History[0])
+		}
+	}
+}
+
+func TestSolvers_Errors(t *testing.T) {
+	a := laplacian2D(3)
+	b := make(vectors.Vector[float64], 9)
+	failing := OperatorFunc(func(x vectors.Vector[float64]) (vectors.Vector[float64], error) {
+		return nil, errors.New("operator failed")
+	})
+	short := OperatorFunc(func(x vectors.Vector[float64]) (vectors.Vector[float64], error) {
+		return x[:1], nil
+	})
+
+	tests := []struct {
+		name   string
+		a      Operator
+		b      vectors.Vector[float64]
+		s      *Settings
+		target error // Sentinel the error must match, if any
+	}{
+		{"nil operator", nil, b, nil, matrix.ErrInvalidArgument},
+		{"empty rhs", a, vectors.Vector[float64]{}, nil, vectors.ErrEmpty},
+		{"dimension mismatch", a, make(vectors.Vector[float64], 4), nil, matrix.ErrDimensionMismatch},
+		{"initial guess length", a, b, &Settings{X0: vectors.Vector[float64]{1}}, matrix.ErrDimensionMismatch},
+		{"negative tolerance", a, b, &Settings{Tol: -1}, matrix.ErrInvalidArgument},
+		{"negative max iterations", a, b, &Settings{MaxIter: -1}, matrix.ErrInvalidArgument},
+		{"operator error", failing, b, nil, nil},
+		{"operator result length", short, b, nil, matrix.ErrDimensionMismatch},
+	}
+
+	for _, tt := range tests {
+		for name, solve := range map[string]solver{"CG": CG[float64], "GMRES": GMRES[float64], "BiCGSTAB": BiCGSTAB[float64]} {
+			_, err := solve(tt.a, tt.b, tt.s)
+			if err == nil {
+				t.Errorf("%s/%s: expected error", name, tt.name)
+			} else if tt.target != nil && !errors.Is(err, tt.target) {
+				t.Errorf("%s/%s: expected %v, got %v", name, tt.name, tt.target, err)
+			}
+		}
+	}
+}
+
+func TestFromMatrix(t *testing.T) {
+	if _, err := FromMatrix(matrix.Matrix[float64]{}); err == nil {
+		t.Error("expected error for empty matrix")
+	}
+	if _, err := FromMatrix(matrix.Matrix[int]{{1, 2}, {3}}); err == nil {
+		t.Error("expected error for jagged matrix")
+	}
+	a, err := FromMatrix(matrix.Matrix[int]{{1, 2}, {3, 4}, {5, 6}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := CG(a, vectors.Vector[int]{1, 2, 3}, nil); err == nil {
+		t.Error("expected error for non-square operator")
+	}
+}
+
+func TestOperatorFunc(t *testing.T) {
+	// Matrix-free application of tridiag(-1, 2, -1)
+	n := 50
+	a := OperatorFunc(func(x vectors.Vector[float64]) (vectors.Vector[float64], error) {
+		y := make(vectors.Vector[float64], len(x))
+		for i := range x {
+			y[i] = 2 * x[i]
+			if i > 0 {
+				y[i] -= x[i-1]
+			}
+			if i < len(x)-1 {
+				y[i] -= x[i+1]
+			}
+		}
+		return y, nil
+	})
+
+	want, b := rhsFor(t, a, n)
+	res, err := CG(a, b, &Settings{Tol: 1e-12})
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkSolution(t, res, want, 1e-8)
+	if res.Iterations > n {
+		t.Errorf("CG took %d iterations on a %d×%d system", res.Iterations, n, n)
+	}
+}
+
+// vectorsAlmostEqual reports whether two vectors have the same length and elements within tol.
+func vectorsAlmostEqual(a, b vectors.Vector[float64], tol float64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if math.Abs(a[i]-b[i]) > tol {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,223 @@
+package iterative
+
+import (
+	"testing"
+
+	"github.com/rickykimani/linalg/matrix"
+	"github.com/rickykimani/linalg/sparse"
+	"github.com/rickykimani/linalg/vectors"
+)
+
+func TestJacobi(t *testing.T) {
+	a, _ := FromMatrix(matrix.Matrix[int]{{2, 1}, {1, 4}})
+	p, err := NewJacobi(a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	z, err := p.Apply(vectors.Vector[float64]{2, 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if z[0] != 1 || z[1] != 0.5 {
+		t.Errorf("expected [1 0.5], got %v", z)
+	}
+}
+
+func TestSSOR(t *testing.T) {
+	// On a diagonal matrix every sweep reduces to a division by the diagonal
+	d, _ := FromMatrix(matrix.Matrix[int]{{2, 0}, {0, 4}})
+	p, err := NewSSOR(d, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	z, _ := p.Apply(vectors.Vector[float64]{2, 2})
+	if !vectorsAlmostEqual(z, vectors.Vector[float64]{1, 0.5}, 1e-14) {
+		t.Errorf("expected [1 0.5], got %v", z)
+	}
+
+	// M = (D + L)·D⁻¹·(D + U) for ω = 1: check M·z = r
+	a := matrix.Matrix[float64]{{4, -1, 0}, {-1, 4, -1}, {0, -1, 4}}
+	op, _ := FromMatrix(a)
+	p, _ = NewSSOR(op, 1)
+	r := vectors.Vector[float64]{1, 2, 3}
+	z, _ = p.Apply(r)
+	lower := matrix.Matrix[float64]{{4, 0, 0}, {-1, 4, 0}, {0, -1, 4}}
+	upper := matrix.Matrix[float64]{{4, -1, 0}, {0, 4, -1}, {0, 0, 4}}
+	y, _ := matrix.MultiplyVector(upper, z)
+	for i := range y {
+		y[i] /= 4
+	}
+	got, _ := matrix.MultiplyVector(lower, y)
+	if !vectorsAlmostEqual(got, r, 1e-12) {
+		t.Errorf("M·z = %v, expected %v", got, r)
+	}
+}
+
+func TestPreconditioners_Exact(t *testing.T) {
+	// ILU(0) and IC(0) of a tridiagonal matrix create no fill-in, so they are
+	// exact factorizations and applying them solves the system
+	tri, _ := sparse.FromMatrix(matrix.Matrix[float64]{
+		{4, -1, 0, 0},
+		{-1, 4, -1, 0},
+		{0, -1, 4, -1},
+		{0, 0, -1, 4},
+	})
+	x := vectors.Vector[float64]{1, -2, 3, -4}
+	b, _ := tri.MulVec(x)
+
+	ilu, err := NewILU0(tri)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ic, err := NewIC0(tri)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, p := range map[string]Preconditioner{"ILU0": ilu, "IC0": ic} {
+		z, err := p.Apply(b)
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if !vectorsAlmostEqual(z, x, 1e-12) {
+			t.Errorf("%s: expected %v, got %v", name, x, z)
+		}
+	}
+
+	// A nonsymmetric tridiagonal system for ILU(0) alone
+	ns := convectionDiffusion(20, 2)
+	_, b = rhsFor(t, ns, 20)
+	ilu, err = NewILU0(ns)
+	if err != nil {
+		t.Fatal(err)
+	}
+	z, _ := ilu.Apply(b)
+	want, _ := rhsFor(t, ns, 20)
+	if !vectorsAlmostEqual(z, want, 1e-10) {
+		t.Errorf("ILU0 of a tridiagonal matrix is not exact: got %v", z)
+	}
+}
+
+func TestPreconditioners_Formats(t *testing.T) {
+	// Every storage format yields the same preconditioner
+	csr := laplacian2D(4)
+	dense, _ := FromMatrix(csr.ToMatrix())
+	coo, _ := sparse.NewCOO(16, 16)
+	csr.Do(func(i, j int, v float64) { coo.Add(i, j, v) })
+	r := make(vectors.Vector[float64], 16)
+	for i := range r {
+		r[i] = float64(i + 1)
+	}
+
+	build := map[string]func(Operator) (Preconditioner, error){
+		"Jacobi": func(a Operator) (Preconditioner, error) { return NewJacobi(a) },
+		"SSOR":   func(a Operator) (Preconditioner, error) { return NewSSOR(a, 1.2) },
+		"ILU0":   func(a Operator) (Preconditioner, error) { return NewILU0(a) },
+		"IC0":    func(a Operator) (Preconditioner, error) { return NewIC0(a) },
+	}
+	for name, newP := range build {
+		var want vectors.Vector[float64]
+		for _, a := range []Operator{csr, csr.ToCSC(), coo, dense} {
+			p, err := newP(a)
+			if err != nil {
+				t.Fatalf("%s: %v", name, err)
+			}
+			z, err := p.Apply(r)
+			if err != nil {
+				t.Fatalf("%s: %v", name, err)
+			}
+			if want == nil {
+				want = z
+			} else if !vectorsAlmostEqual(z, want, 1e-12) {
+				t.Errorf("%s: %T gives %v, expected %v", name, a, z, want)
+			}
+		}
+	}
+}
+
+func TestPreconditioners_SpeedUp(t *testing.T) {
+	a := laplacian2D(30)
+	want, b := rhsFor(t, a, 900)
+	base, err := CG(a, b, &Settings{Tol: 1e-10})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ic, _ := NewIC0(a)
+	ssor, _ := NewSSOR(a, 1.5)
+	ilu, _ := NewILU0(a)
+	tests := []struct {
+		name  string
+		solve solver
+		p     Preconditioner
+	}{
+		{"CG/IC0", CG[float64], ic},
+		{"CG/SSOR", CG[float64], ssor},
+		{"GMRES/ILU0", GMRES[float64], ilu},
+		{"BiCGSTAB/ILU0", BiCGSTAB[float64], ilu},
+	}
+	for _, tt := range tests {
+		res, err := tt.solve(a, b, &Settings{Tol: 1e-10, Precond: tt.p})
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		t.Run(tt.name, func(t *testing.T) { checkSolution(t, res, want, 1e-7) })
+		if res.Iterations >= base.Iterations {
+			t.Errorf("%s: %d iterations, unpreconditioned CG took %d", tt.name, res.Iterations, base.Iterations)
+		}
+	}
+}
+
+func TestPreconditioners_Errors(t *testing.T) {
+	zeroDiag, _ := FromMatrix(matrix.Matrix[int]{{0, 1}, {1, 1}})
+	nonSquare, _ := FromMatrix(matrix.Matrix[int]{{1, 2, 3}, {4, 5, 6}})
+	nonSym, _ := FromMatrix(matrix.Matrix[int]{{2, 1}, {0, 2}})
+	indefinite, _ := FromMatrix(matrix.Matrix[int]{{1, 2}, {2, 1}})
+	zeroPivot, _ := FromMatrix(matrix.Matrix[int]{{1, 1}, {1, 1}})
+	empty, _ := sparse.NewCSR(0, 0, []int{0}, nil, nil)
+	fn := OperatorFunc(func(x vectors.Vector[float64]) (vectors.Vector[float64], error) { return x, nil })
+
+	tests := []struct {
+		name string
+		newP func() (Preconditioner, error)
+	}{
+		{"Jacobi/zero diagonal", func() (Preconditioner, error) { return NewJacobi(zeroDiag) }},
+		{"Jacobi/non-square", func() (Preconditioner, error) { return NewJacobi(nonSquare) }},
+		{"Jacobi/empty", func() (Preconditioner, error) { return NewJacobi(empty) }},
+		{"Jacobi/operator function", func() (Preconditioner, error) { return NewJacobi(fn) }},
+		{"Jacobi/nil", func() (Preconditioner, error) { return NewJacobi(nil) }},
+		{"SSOR/omega zero", func() (Preconditioner, error) { return NewSSOR(indefinite, 0) }},
+		{"SSOR/omega two", func() (Preconditioner, error) { return NewSSOR(indefinite, 2) }},
+		{"SSOR/zero diagonal", func() (Preconditioner, error) { return NewSSOR(zeroDiag, 1) }},
+		{"ILU0/zero diagonal", func() (Preconditioner, error) { return NewILU0(zeroDiag) }},
+		{"ILU0/zero pivot", func() (Preconditioner, error) { return NewILU0(zeroPivot) }},
+		{"IC0/not symmetric", func() (Preconditioner, error) { return NewIC0(nonSym) }},
+		{"IC0/indefinite", func() (Preconditioner, error) { return NewIC0(indefinite) }},
+		{"IC0/zero diagonal", func() (Preconditioner, error) { return NewIC0(zeroDiag) }},
+	}
+	for _, tt := range tests {
+		if _, err := tt.newP(); err == nil {
+			t.Errorf("%s: expected error", tt.name)
+		}
+	}
+
+	p, _ := NewJacobi(laplacian2D(2))
+	if _, err := p.Apply(vectors.Vector[float64]{1}); err == nil {
+		t.Error("expected error for wrong vector length")
+	}
+	a := laplacian2D(2)
+	if _, err := CG(a, vectors.Vector[float64]{1, 2, 3, 4}, &Settings{Precond: p}); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	small, _ := NewJacobi(laplacian2D(1))
+	if _, err := CG(a, vectors.Vector[float64]{1, 2, 3, 4}, &Settings{Precond: small}); err == nil {
+		t.Error("expected error for mismatched preconditioner")
+	}
+}
+
+func BenchmarkILU0(b *testing.B) {
+	a := laplacian2D(50)
+	b.ResetTimer()
+	for b.Loop() {
+		NewILU0(a)
+	}
+}
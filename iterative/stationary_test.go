@@ -0,0 +1,221 @@
+package iterative
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/rickykimani/linalg/matrix"
+	"github.com/rickykimani/linalg/vectors"
+)
+
+// tridiag returns the n×n matrix tridiag(-1, d, -1) as a dense operator.
+func tridiag(n int, d float64) Operator {
+	m, _ := matrix.NewEmptyMatrix(n, n)
+	for i := range n {
+		m[i][i] = d
+		if i > 0 {
+			m[i][i-1] = -1
+			m[i-1][i] = -1
+		}
+	}
+	op, _ := FromMatrix(m)
+	return op
+}
+
+func TestStationary(t *testing.T) {
+	dominant, _ := FromMatrix(matrix.Matrix[float64]{{10, -1, 2, 0}, {-1, 11, -1, 3}, {2, -1, 10, -1}, {0, 3, -1, 8}})
+	want := vectors.Vector[float64]{1, 2, -1, 1}
+	b, _ := dominant.MulVec(want)
+	grid := laplacian2D(5)
+	gridX, gridB := rhsFor(t, grid, 25)
+
+	tests := []struct {
+		name  string
+		solve solver
+		a     Operator
+		b     vectors.Vector[float64]
+		want  vectors.Vector[float64]
+		s     *Settings
+	}{
+		{"JOR", JOR[float64], dominant, b, want, nil},
+		{"JOR/damped", JOR[float64], dominant, b, want, &Settings{Omega: 0.8}},
+		{"GaussSeidel", GaussSeidel[float64], dominant, b, want, nil},
+		{"SOR/under-relaxed", SOR[float64], dominant, b, want, &Settings{Omega: 0.9}},
+		{"SOR/over-relaxed", SOR[float64], dominant, b, want, &Settings{Omega: 1.1}},
+		{"GaussSeidel/sparse", GaussSeidel[float64], grid, gridB, gridX, &Settings{Tol: 1e-10}},
+		{"SOR/sparse", SOR[float64], grid, gridB, gridX, &Settings{Omega: 1.5, Tol: 1e-10}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := tt.solve(tt.a, tt.b, tt.s)
+			if err != nil {
+				t.Fatal(err)
+			}
+			checkSolution(t, res, tt.want, 1e-7)
+			if res.History[len(res.History)-1] != res.Residual {
+				t.Errorf("history %v inconsistent with residual %g", res.History, res.Residual)
+			}
+		})
+	}
+}
+
+func TestStationary_IntRHS(t *testing.T) {
+	a, _ := FromMatrix(matrix.Matrix[int]{{4, 1}, {2, 5}})
+	res, err := JOR(a, vectors.Vector[int]{5, 7}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkSolution(t, res, vectors.Vector[float64]{1, 1}, 1e-7)
+}
+
+func TestStationary_Comparison(t *testing.T) {
+	// On the 1D Poisson matrix Gauss-Seidel needs about half the Jacobi
+	// iterations, and SOR with the optimal ω far fewer still
+	m, _ := matrix.NewEmptyMatrix(20, 20)
+	for i := range 20 {
+		m[i][i] = 2
+		if i > 0 {
+			m[i][i-1], m[i-1][i] = -1, -1
+		}
+	}
+	a, _ := FromMatrix(m)
+	b := make(vectors.Vector[float64], 20)
+	for i := range b {
+		b[i] = 1
+	}
+	s := &Settings{Tol: 1e-6, MaxIter: 10000}
+
+	jac, _ := JOR(a, b, s)
+	gs, _ := GaussSeidel(a, b, s)
+	w, err := matrix.OptimalOmega(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sor, _ := SOR(a, b, &Settings{Omega: w, Tol: 1e-6, MaxIter: 10000})
+
+	if !jac.Converged || !gs.Converged || !sor.Converged {
+		t.Fatalf("expected all methods to converge: %v %v %v", jac.Converged, gs.Converged, sor.Converged)
+	}
+	if ratio := float64(jac.Iterations) / float64(gs.Iterations); ratio < 1.8 || ratio > 2.2 {
+		t.Errorf("Jacobi took %d iterations, Gauss-Seidel %d: expected a ratio of about 2", jac.Iterations, gs.Iterations)
+	}
+	if sor.Iterations*5 > gs.Iterations {
+		t.Errorf("optimal SOR took %d iterations, Gauss-Seidel %d", sor.Iterations, gs.Iterations)
+	}
+}
+
+func TestStationary_Settings(t *testing.T) {
+	a := tridiag(10, 3)
+	b := make(vectors.Vector[float64], 10)
+	b[0] = 1
+
+	// Callback sees every iteration and can stop early
+	var seen []int
+	res, err := GaussSeidel(a, b, &Settings{Callback: func(iter int, residual float64) bool {
+		seen = append(seen, iter)
+		return iter < 4
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Iterations != 4 || len(seen) != 4 || res.Converged {
+		t.Errorf("expected to stop after 4 iterations, got %d (callbacks %v)", res.Iterations, seen)
+	}
+	for i := 1; i < len(res.History); i++ {
+		if res.History[i] >= res.History[i-1] {
+			t.Errorf("residual did not decrease at iteration %d: %v", i, res.History)
+		}
+	}
+
+	// MaxIter
+	res, _ = JOR(a, b, &Settings{MaxIter: 3})
+	if res.Iterations != 3 || res.Converged {
+		t.Errorf("expected 3 unconverged iterations, got %d", res.Iterations)
+	}
+
+	// A loose step tolerance stops before the residual tolerance is met
+	res, _ = JOR(a, b, &Settings{StepTol: 0.1, Tol: 1e-14})
+	if res.Converged || res.Iterations == 0 || res.Iterations > 20 {
+		t.Errorf("expected an early unconverged stop, got %d iterations (converged %v)", res.Iterations, res.Converged)
+	}
+
+	// An exact initial guess needs no iterations
+	exact, _ := CG(a, b, &Settings{Tol: 1e-14})
+	res, _ = SOR(a, b, &Settings{X0: exact.X, Omega: 1.5, Tol: 1e-12})
+	if res.Iterations != 0 || !res.Converged {
+		t.Errorf("exact initial guess took %d iterations", res.Iterations)
+	}
+
+	// Zero right-hand side
+	res, _ = JOR(a, make(vectors.Vector[float64], 10), nil)
+	if res.Iterations != 0 || !res.Converged {
+		t.Errorf("zero right-hand side took %d iterations", res.Iterations)
+	}
+}
+
+func TestStationary_Divergence(t *testing.T) {
+	// Jacobi diverges when the iteration matrix has spectral radius above 1
+	a, _ := FromMatrix(matrix.Matrix[float64]{{1, 2}, {2, 1}})
+	res, err := JOR(a, vectors.Vector[float64]{3, 3}, &Settings{MaxIter: 20})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Converged || res.Residual <= res.History[0] {
+		t.Errorf("expected divergence, got residual %g from %g", res.Residual, res.History[0])
+	}
+}
+
+func TestStationary_Errors(t *testing.T) {
+	a := tridiag(2, 2)
+	b := vectors.Vector[float64]{1, 1}
+	zeroDiag, _ := FromMatrix(matrix.Matrix[float64]{{0, 1}, {1, 2}})
+	fn := OperatorFunc(func(x vectors.Vector[float64]) (vectors.Vector[float64], error) { return x, nil })
+
+	tests := []struct {
+		name   string
+		solve  solver
+		a      Operator
+		b      vectors.Vector[float64]
+		s      *Settings
+		target error
+	}{
+		{"nil operator", JOR[float64], nil, b, nil, matrix.ErrInvalidArgument},
+		{"operator function", GaussSeidel[float64], fn, b, nil, matrix.ErrInvalidArgument},
+		{"empty rhs", JOR[float64], a, vectors.Vector[float64]{}, nil, vectors.ErrEmpty},
+		{"length mismatch", JOR[float64], a, vectors.Vector[float64]{1, 2, 3}, nil, matrix.ErrDimensionMismatch},
+		{"zero diagonal", GaussSeidel[float64], zeroDiag, b, nil, matrix.ErrSingular},
+		{"negative tolerance", JOR[float64], a, b, &Settings{Tol: -1}, matrix.ErrInvalidArgument},
+		{"negative step tolerance", JOR[float64], a, b, &Settings{StepTol: -1}, matrix.ErrInvalidArgument},
+		{"negative maxIter", SOR[float64], a, b, &Settings{MaxIter: -1}, matrix.ErrInvalidArgument},
+		{"initial guess length", SOR[float64], a, b, &Settings{X0: vectors.Vector[float64]{1}}, matrix.ErrDimensionMismatch},
+		{"JOR damping", JOR[float64], a, b, &Settings{Omega: 1.5}, matrix.ErrInvalidArgument},
+		{"SOR omega two", SOR[float64], a, b, &Settings{Omega: 2}, matrix.ErrInvalidArgument},
+		{"SOR negative omega", SOR[float64], a, b, &Settings{Omega: -0.5}, matrix.ErrInvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := tt.solve(tt.a, tt.b, tt.s); !errors.Is(err, tt.target) {
+				t.Errorf("expected %v, got %v", tt.target, err)
+			}
+		})
+	}
+
+	// GaussSeidel ignores Omega
+	if _, err := GaussSeidel(a, b, &Settings{Omega: 5}); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func BenchmarkGaussSeidel(b *testing.B) {
+	a := laplacian2D(20)
+	rhs := make(vectors.Vector[float64], 400)
+	for i := range rhs {
+		rhs[i] = 1
+	}
+	b.ResetTimer()
+	for b.Loop() {
+		GaussSeidel(a, rhs, nil)
+	}
+}
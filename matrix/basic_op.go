@@ -1,9 +1,6 @@
 package matrix
 
-import (
-	"errors"
-	"fmt"
-)
+import "fmt"
 
 // Add combines two matrices by adding their corresponding elements.
 //
@@ -14,7 +11,8 @@ import (
 // Returns:
 //   - Matrix[float64]: A new matrix where each element is the sum of the
 //     corresponding elements in a and b
-//   - error: An error if matrices have incompatible dimensions or are empty
+//   - error: An error if matrices are empty (ErrEmpty) or have different dimensions
+//     (*DimensionError, matching ErrDimensionMismatch)
 //
 // Both matrices must have identical dimensions (rows × columns).
 // The result is always a float64 matrix to accommodate mixed-type operations.
@@ -29,12 +27,12 @@ func Add[T, E int | float64](a Matrix[T], b Matrix[E]) (Matrix[float64], error)
 
 	// Handle empty matrices
 	if len(a) == 0 || len(b) == 0 {
-		return nil, errors.New("empty matrix")
+		return nil, ErrEmpty
 	}
 
 	// Check dimension compatibility
-	if len(a) != len(b) {
-		return nil, errors.New("incompatible dimensions")
+	if a.Rows() != b.Rows() || a.Cols() != b.Cols() {
+		return nil, dimensionError("Add", a.shape(), b.shape())
 	}
 
 	rows := len(a)
@@ -43,9 +41,6 @@ func Add[T, E int | float64](a Matrix[T], b Matrix[E]) (Matrix[float64], error)
 	// Perform addition
 	for i := range rows {
 		cols := len(a[i])
-		if len(b[i]) != cols {
-			return nil, errors.New("incompatible row lengths")
-		}
 
 		result[i] = make([]float64, cols)
 		for j := range cols {
@@ -65,7 +60,8 @@ func Add[T, E int | float64](a Matrix[T], b Matrix[E]) (Matrix[float64], error)
 //
 // Returns:
 //   - Matrix[float64]: A new matrix where each element is a[i][j] - b[i][j]
-//   - error: An error if matrices have incompatible dimensions or are empty
+//   - error: An error if matrices are empty (ErrEmpty) or have different dimensions
+//     (*DimensionError, matching ErrDimensionMismatch)
 //
 // Both matrices must have identical dimensions (rows × columns).
 // The result is always a float64 matrix to accommodate mixed-type operations.
@@ -80,12 +76,12 @@ func Subtract[T, E int | float64](a Matrix[T], b Matrix[E]) (Matrix[float64], er
 
 	// Handle empty matrices
 	if len(a) == 0 || len(b) == 0 {
-		return nil, errors.New("empty matrix")
+		return nil, ErrEmpty
 	}
 
 	// Check dimension compatibility
-	if len(a) != len(b) {
-		return nil, errors.New("incompatible dimensions")
+	if a.Rows() != b.Rows() || a.Cols() != b.Cols() {
+		return nil, dimensionError("Subtract", a.shape(), b.shape())
 	}
 
 	rows := len(a)
@@ -94,9 +90,6 @@ func Subtract[T, E int | float64](a Matrix[T], b Matrix[E]) (Matrix[float64], er
 	// Perform subtraction
 	for i := range rows {
 		cols := len(a[i])
-		if len(b[i]) != cols {
-			return nil, errors.New("incompatible row lengths")
-		}
 
 		result[i] = make([]float64, cols)
 		for j := range cols {
@@ -106,3 +99,75 @@ func Subtract[T, E int | float64](a Matrix[T], b Matrix[E]) (Matrix[float64], er
 
 	return result, nil
 }
+
+// AddTo stores the elementwise sum a + b in dst.
+//
+// Parameters:
+//   - dst: Destination matrix with the same dimensions as a and b, typically a view
+//   - a: First matrix of type Matrix[T] where T is int or float64
+//   - b: Second matrix of type Matrix[E] where E is int or float64
+//
+// Returns:
+//   - error: An error if any matrix is invalid or empty, or the dimensions differ
+//
+// No memory is allocated. dst may be the same matrix as a or b, which makes
+// AddTo(c, c, b) an in-place update.
+func AddTo[T, E int | float64](dst Matrix[float64], a Matrix[T], b Matrix[E]) error {
+	if err := checkElementwise("AddTo", dst, a, b); err != nil {
+		return err
+	}
+
+	for i := range dst {
+		for j := range dst[i] {
+			dst[i][j] = float64(a[i][j]) + float64(b[i][j])
+		}
+	}
+	return nil
+}
+
+// SubtractTo stores the elementwise difference a - b in dst.
+//
+// Parameters:
+//   - dst: Destination matrix with the same dimensions as a and b, typically a view
+//   - a: First matrix of type Matrix[T] where T is int or float64
+//   - b: Second matrix of type Matrix[E] where E is int or float64
+//
+// Returns:
+//   - error: An error if any matrix is invalid or empty, or the dimensions differ
+//
+// No memory is allocated. dst may be the same matrix as a or b.
+func SubtractTo[T, E int | float64](dst Matrix[float64], a Matrix[T], b Matrix[E]) error {
+	if err := checkElementwise("SubtractTo", dst, a, b); err != nil {
+		return err
+	}
+
+	for i := range dst {
+		for j := range dst[i] {
+			dst[i][j] = float64(a[i][j]) - float64(b[i][j])
+		}
+	}
+	return nil
+}
+
+// checkElementwise verifies that dst, a and b are valid, non-empty and have
+// identical dimensions, naming op in a DimensionError.
+func checkElementwise[T, E int | float64](op string, dst Matrix[float64], a Matrix[T], b Matrix[E]) error {
+	if err := a.Validate(); err != nil {
+		return fmt.Errorf("first matrix: %w", err)
+	}
+	if err := b.Validate(); err != nil {
+		return fmt.Errorf("second matrix: %w", err)
+	}
+	if err := dst.Validate(); err != nil {
+		return fmt.Errorf("destination: %w", err)
+	}
+
+	if len(a) == 0 || len(b) == 0 || len(dst) == 0 {
+		return ErrEmpty
+	}
+
+	if a.Rows() != b.Rows() || a.Cols() != b.Cols() || a.Rows() != dst.Rows() || a.Cols() != dst.Cols() {
+		return dimensionError(op, a.shape(), b.shape(), dst.shape())
+	}
+	return nil
+}
@@ -0,0 +1,138 @@
+package matrix
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/rickykimani/linalg/vectors"
+)
+
+func TestNewCholesky(t *testing.T) {
+	A := Matrix[int]{
+		{4, 12, -16},
+		{12, 37, -43},
+		{-16, -43, 98},
+	}
+
+	c, err := NewCholesky(A)
+	if err != nil {
+		t.Fatalf("NewCholesky() error = %v", err)
+	}
+
+	wantL := Matrix[float64]{
+		{2, 0, 0},
+		{6, 1, 0},
+		{-8, 5, 3},
+	}
+	if !matricesAlmostEqual(c.L(), wantL, 1e-12) {
+		t.Errorf("L() = %v, want %v", c.L(), wantL)
+	}
+
+	if det := c.Det(); math.Abs(det-36) > 1e-9 {
+		t.Errorf("Det() = %v, want 36", det)
+	}
+	if logDet := c.LogDet(); math.Abs(logDet-math.Log(36)) > 1e-12 {
+		t.Errorf("LogDet() = %v, want %v", logDet, math.Log(36))
+	}
+
+	want := vectors.Vector[float64]{1, -1, 2}
+	b, _ := MultiplyVector(A, want)
+	x, err := c.SolveVector(b)
+	if err != nil {
+		t.Fatalf("SolveVector() error = %v", err)
+	}
+	for i := range want {
+		if math.Abs(x[i]-want[i]) > 1e-10 {
+			t.Errorf("x[%d] = %v, want %v", i, x[i], want[i])
+		}
+	}
+
+	inv, err := c.Inverse()
+	if err != nil {
+		t.Fatalf("Inverse() error = %v", err)
+	}
+	product, _ := Multiply(A, inv)
+	if !matricesAlmostEqual(product, Identity(3), 1e-10) {
+		t.Errorf("A·A⁻¹ != I\n%s", matrixToString(product))
+	}
+
+	if _, err := c.SolveVector(vectors.Vector[float64]{1}); err == nil {
+		t.Error("expected error for mismatched vector length")
+	}
+}
+
+func TestNewCholesky_LogDetDoesNotOverflow(t *testing.T) {
+	n := 400
+	A := Identity(n)
+	for i := range n {
+		A[i][i] = 1e10
+	}
+
+	c, err := NewCholesky(A)
+	if err != nil {
+		t.Fatalf("NewCholesky() error = %v", err)
+	}
+	if !math.IsInf(c.Det(), 1) {
+		t.Errorf("Det() = %v, expected overflow to +Inf", c.Det())
+	}
+	if want := float64(n) * math.Log(1e10); math.Abs(c.LogDet()-want) > 1e-8 {
+		t.Errorf("LogDet() = %v, want %v", c.LogDet(), want)
+	}
+}
+
+func TestNewCholesky_Errors(t *testing.T) {
+	t.Run("not positive definite names the pivot", func(t *testing.T) {
+		A := Matrix[int]{
+			{1, 2, 0},
+			{2, 1, 0},
+			{0, 0, 1},
+		}
+		_, err := NewCholesky(A)
+		var pdErr *NotPositiveDefiniteError
+		if !errors.As(err, &pdErr) {
+			t.Fatalf("expected *NotPositiveDefiniteError, got %v", err)
+		}
+		if !errors.Is(err, ErrNotPositiveDefinite) {
+			t.Error("error does not match ErrNotPositiveDefinite")
+		}
+		if pdErr.Pivot != 1 || pdErr.Value != -3 {
+			t.Errorf("got pivot %d with value %v, want pivot 1 with value -3", pdErr.Pivot, pdErr.Value)
+		}
+	})
+
+	t.Run("semidefinite", func(t *testing.T) {
+		_, err := NewCholesky(Matrix[int]{{1, 1}, {1, 1}})
+		if !errors.Is(err, ErrNotPositiveDefinite) {
+			t.Errorf("expected ErrNotPositiveDefinite, got %v", err)
+		}
+	})
+
+	t.Run("not symmetric", func(t *testing.T) {
+		_, err := NewCholesky(Matrix[int]{{2, 1}, {0, 2}})
+		if !errors.Is(err, ErrNotSymmetric) {
+			t.Errorf("expected ErrNotSymmetric, got %v", err)
+		}
+	})
+
+	t.Run("shape", func(t *testing.T) {
+		if _, err := NewCholesky(Matrix[int]{}); err == nil {
+			t.Error("expected error for empty matrix")
+		}
+		if _, err := NewCholesky(Matrix[int]{{1, 2}}); err == nil {
+			t.Error("expected error for non-square matrix")
+		}
+	})
+}
+
+func BenchmarkCholesky100x100(b *testing.B) {
+	R := randomFloatMatrix(100, 100)
+	A, _ := Multiply(Transpose(R), R)
+	for i := range A {
+		A[i][i] += 100
+	}
+
+	for b.Loop() {
+		_, _ = NewCholesky(A)
+	}
+}
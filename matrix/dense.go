@@ -0,0 +1,219 @@
+package matrix
+
+import "fmt"
+
+// Dense is a float64 matrix stored in a single contiguous row-major slice.
+//
+// Element (i, j) lives at data[i*stride+j]. The stride is the distance
+// between the starts of consecutive rows and is at least the number of
+// columns, which lets a Dense describe a block inside a larger array without
+// copying. Compared with Matrix[T], whose rows are separate allocations, a
+// Dense has a fixed shape, needs a single allocation, and is laid out the way
+// BLAS, LAPACK and most other numerical libraries expect.
+//
+// Every operation in this package that accepts a Matrix can be applied to a
+// Dense through its Matrix method, which shares the underlying storage.
+type Dense struct {
+	rows   int
+	cols   int
+	stride int
+	data   []float64
+}
+
+// NewDense creates a rows×cols Dense matrix.
+//
+// Parameters:
+//   - rows: The number of rows
+//   - cols: The number of columns
+//   - data: The elements in row-major order, or nil for a zero matrix
+//
+// Returns:
+//   - *Dense: The new matrix with stride equal to cols
+//   - error: An error if a dimension is negative (ErrInvalidArgument) or
+//     len(data) is not rows*cols (ErrDimensionMismatch)
+//
+// A non-nil data slice is used as the backing storage without copying, so
+// later changes through either the slice or the matrix are visible to both.
+// This is what makes zero-copy exchange with other libraries possible.
+//
+// Example:
+//
+//	d, _ := NewDense(2, 3, []float64{1, 2, 3, 4, 5, 6})  // [[1, 2, 3], [4, 5, 6]]
+func NewDense(rows, cols int, data []float64) (*Dense, error) {
+	if rows < 0 || cols < 0 {
+		return nil, fmt.Errorf("matrix dimensions cannot be negative: got %d×%d: %w", rows, cols, ErrInvalidArgument)
+	}
+
+	if data == nil {
+		data = make([]float64, rows*cols)
+	} else if len(data) != rows*cols {
+		return nil, fmt.Errorf("data length %d does not match dimensions %d×%d: %w", len(data), rows, cols, ErrDimensionMismatch)
+	}
+
+	return &Dense{rows: rows, cols: cols, stride: cols, data: data}, nil
+}
+
+// NewDenseFromMatrix copies a Matrix into a new Dense matrix.
+//
+// Parameters:
+//   - m: Input matrix of type Matrix[T] where T is int or float64
+//
+// Returns:
+//   - *Dense: A Dense copy of m with stride equal to its column count
+//   - error: An error if the matrix rows have inconsistent lengths
+func NewDenseFromMatrix[T int | float64](m Matrix[T]) (*Dense, error) {
+	if err := m.Validate(); err != nil {
+		return nil, err
+	}
+
+	rows, cols := m.Rows(), m.Cols()
+	d := &Dense{rows: rows, cols: cols, stride: cols, data: make([]float64, rows*cols)}
+	for i, row := range m {
+		dst := d.data[i*cols : (i+1)*cols]
+		for j, v := range row {
+			dst[j] = float64(v)
+		}
+	}
+	return d, nil
+}
+
+// Dims returns the number of rows and columns of the matrix.
+func (d *Dense) Dims() (rows, cols int) {
+	return d.rows, d.cols
+}
+
+// Stride returns the distance in elements between the starts of consecutive rows.
+func (d *Dense) Stride() int {
+	return d.stride
+}
+
+// RawData returns the backing slice of the matrix without copying.
+//
+// Element (i, j) is at index i*Stride()+j. When the stride exceeds the column
+// count, as for a view created with Slice, the slice also contains elements
+// that do not belong to the matrix.
+func (d *Dense) RawData() []float64 {
+	return d.data
+}
+
+// Get retrieves the value at the specified row and column.
+//
+// Parameters:
+//   - row: The row index (0-based)
+//   - col: The column index (0-based)
+//
+// Returns:
+//   - float64: The value at the specified position
+//   - error: An error if either index is out of bounds (ErrInvalidArgument)
+func (d *Dense) Get(row, col int) (float64, error) {
+	if err := d.checkIndex(row, col); err != nil {
+		return 0, err
+	}
+	return d.data[row*d.stride+col], nil
+}
+
+// Set modifies the value at the specified row and column.
+//
+// Parameters:
+//   - row: The row index (0-based)
+//   - col: The column index (0-based)
+//   - val: The new value to set at the specified position
+//
+// Returns:
+//   - error: An error if either index is out of bounds (ErrInvalidArgument)
+func (d *Dense) Set(row, col int, val float64) error {
+	if err := d.checkIndex(row, col); err != nil {
+		return err
+	}
+	d.data[row*d.stride+col] = val
+	return nil
+}
+
+// checkIndex returns an error if (row, col) lies outside the matrix.
+func (d *Dense) checkIndex(row, col int) error {
+	if row < 0 || row >= d.rows {
+		return fmt.Errorf("row index %d out of bounds for matrix with %d rows: %w", row, d.rows, ErrInvalidArgument)
+	}
+	if col < 0 || col >= d.cols {
+		return fmt.Errorf("column index %d out of bounds for matrix with %d columns: %w", col, d.cols, ErrInvalidArgument)
+	}
+	return nil
+}
+
+// Matrix returns a Matrix[float64] whose rows share storage with d.
+//
+// Only the slice headers for the rows are allocated; writes through the
+// returned Matrix modify d and vice versa. Each row is capped at the column
+// count, so appending to a row reallocates it instead of overwriting the next
+// row. A matrix with no rows or columns yields an empty Matrix.
+//
+// Example:
+//
+//	d, _ := NewDense(2, 2, []float64{2, 1, 1, 3})
+//	det, _ := Det(d.Matrix())  // 5
+func (d *Dense) Matrix() Matrix[float64] {
+	if d.rows == 0 || d.cols == 0 {
+		return Matrix[float64]{}
+	}
+
+	m := make(Matrix[float64], d.rows)
+	for i := range m {
+		start := i * d.stride
+		m[i] = d.data[start : start+d.cols : start+d.cols]
+	}
+	return m
+}
+
+// Slice returns a view of the submatrix with rows r0 to r1-1 and columns c0
+// to c1-1.
+//
+// Parameters:
+//   - r0, r1: The half-open row range [r0, r1)
+//   - c0, c1: The half-open column range [c0, c1)
+//
+// Returns:
+//   - *Dense: An (r1-r0)×(c1-c0) matrix sharing storage with d and keeping its stride
+//   - error: An error if a range is out of bounds (ErrInvalidArgument)
+//
+// No elements are copied; writes through the view change d and vice versa.
+func (d *Dense) Slice(r0, r1, c0, c1 int) (*Dense, error) {
+	if err := checkSliceBounds(r0, r1, c0, c1, d.rows, d.cols); err != nil {
+		return nil, err
+	}
+
+	view := &Dense{rows: r1 - r0, cols: c1 - c0, stride: d.stride}
+	if view.rows > 0 && view.cols > 0 {
+		view.data = d.data[r0*d.stride+c0 : (r1-1)*d.stride+c1]
+	}
+	return view, nil
+}
+
+// Row returns a 1×n view of row i that shares storage with d.
+func (d *Dense) Row(i int) (*Dense, error) {
+	if i < 0 || i >= d.rows {
+		return nil, fmt.Errorf("row index %d out of bounds for matrix with %d rows: %w", i, d.rows, ErrInvalidArgument)
+	}
+	return d.Slice(i, i+1, 0, d.cols)
+}
+
+// Col returns an m×1 view of column j that shares storage with d.
+func (d *Dense) Col(j int) (*Dense, error) {
+	if j < 0 || j >= d.cols {
+		return nil, fmt.Errorf("column index %d out of bounds for matrix with %d columns: %w", j, d.cols, ErrInvalidArgument)
+	}
+	return d.Slice(0, d.rows, j, j+1)
+}
+
+// Clone returns a deep copy of d with a compact stride equal to its column count.
+func (d *Dense) Clone() *Dense {
+	c := &Dense{rows: d.rows, cols: d.cols, stride: d.cols, data: make([]float64, d.rows*d.cols)}
+	for i := range d.rows {
+		copy(c.data[i*d.cols:(i+1)*d.cols], d.data[i*d.stride:i*d.stride+d.cols])
+	}
+	return c
+}
+
+// Format implements the fmt.Formatter interface using the same layout as Matrix.
+func (d *Dense) Format(f fmt.State, verb rune) {
+	d.Matrix().Format(f, verb)
+}
@@ -0,0 +1,184 @@
+package matrix
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestNewDense(t *testing.T) {
+	tests := []struct {
+		name      string
+		rows      int
+		cols      int
+		data      []float64
+		expected  Matrix[float64]
+		wantError bool
+	}{
+		{
+			name:     "From row-major data",
+			rows:     2,
+			cols:     3,
+			data:     []float64{1, 2, 3, 4, 5, 6},
+			expected: Matrix[float64]{{1, 2, 3}, {4, 5, 6}},
+		},
+		{
+			name:     "Zero matrix from nil data",
+			rows:     2,
+			cols:     2,
+			expected: Matrix[float64]{{0, 0}, {0, 0}},
+		},
+		{
+			name:     "Empty matrix",
+			rows:     0,
+			cols:     3,
+			expected: Matrix[float64]{},
+		},
+		{
+			name:      "Negative dimension",
+			rows:      -1,
+			cols:      2,
+			wantError: true,
+		},
+		{
+			name:      "Data length mismatch",
+			rows:      2,
+			cols:      2,
+			data:      []float64{1, 2, 3},
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, err := NewDense(tt.rows, tt.cols, tt.data)
+			if tt.wantError {
+				if err == nil {
+					t.Error("expected error but got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("NewDense() error = %v", err)
+			}
+
+			if r, c := d.Dims(); r != tt.rows || c != tt.cols {
+				t.Errorf("Dims() = %d×%d, expected %d×%d", r, c, tt.rows, tt.cols)
+			}
+			if d.Stride() != tt.cols {
+				t.Errorf("Stride() = %d, expected %d", d.Stride(), tt.cols)
+			}
+			if len(tt.expected) == 0 {
+				if len(d.Matrix()) != 0 {
+					t.Errorf("Matrix() = %v, expected an empty matrix", d.Matrix())
+				}
+				return
+			}
+			if !matricesAlmostEqual(d.Matrix(), tt.expected, 0) {
+				t.Errorf("Matrix() = %v, expected %v", d.Matrix(), tt.expected)
+			}
+		})
+	}
+}
+
+func TestDense_SharedStorage(t *testing.T) {
+	data := []float64{1, 2, 3, 4}
+	d, _ := NewDense(2, 2, data)
+
+	// NewDense does not copy its data
+	data[3] = 40
+	if v, _ := d.Get(1, 1); v != 40 {
+		t.Errorf("Get(1, 1) = %v after writing the data slice, expected 40", v)
+	}
+
+	// Matrix shares storage in both directions
+	m := d.Matrix()
+	m[0][1] = 20
+	if v, _ := d.Get(0, 1); v != 20 {
+		t.Errorf("Get(0, 1) = %v after writing through Matrix, expected 20", v)
+	}
+	if err := d.Set(1, 0, 30); err != nil {
+		t.Fatalf("Set() error = %v", err)
+	}
+	if m[1][0] != 30 || d.RawData()[2] != 30 {
+		t.Errorf("Set(1, 0) not visible through Matrix or RawData")
+	}
+
+	// Appending to a row must not overwrite the next row
+	_ = append(m[0], 99)
+	if m[1][0] != 30 {
+		t.Errorf("append to row 0 overwrote row 1: %v", m[1])
+	}
+
+	// Clone is independent
+	c := d.Clone()
+	_ = c.Set(0, 0, -1)
+	if v, _ := d.Get(0, 0); v != 1 {
+		t.Errorf("modifying a clone changed the original")
+	}
+}
+
+func TestDense_Operations(t *testing.T) {
+	d, _ := NewDenseFromMatrix(Matrix[int]{{2, 1}, {1, 3}})
+
+	det, err := Det(d.Matrix())
+	if err != nil {
+		t.Fatalf("Det() error = %v", err)
+	}
+	if !approxEqual(det, 5) {
+		t.Errorf("Det() = %v, expected 5", det)
+	}
+
+	product, err := Multiply(d.Matrix(), d.Matrix())
+	if err != nil {
+		t.Fatalf("Multiply() error = %v", err)
+	}
+	if !matricesAlmostEqual(product, Matrix[float64]{{5, 5}, {5, 10}}, 0) {
+		t.Errorf("Multiply() = %v", product)
+	}
+
+	if got := fmt.Sprintf("%v", d); got != fmt.Sprintf("%v", d.Matrix()) {
+		t.Errorf("Format = %q, expected the Matrix layout", got)
+	}
+}
+
+func TestDense_Errors(t *testing.T) {
+	if _, err := NewDenseFromMatrix(Matrix[int]{{1, 2}, {3}}); err == nil {
+		t.Error("expected error for improper matrix")
+	}
+
+	d, _ := NewDense(2, 3, nil)
+	for _, idx := range [][2]int{{-1, 0}, {2, 0}, {0, -1}, {0, 3}} {
+		if _, err := d.Get(idx[0], idx[1]); err == nil {
+			t.Errorf("Get(%d, %d): expected error", idx[0], idx[1])
+		}
+		if err := d.Set(idx[0], idx[1], 1); err == nil {
+			t.Errorf("Set(%d, %d): expected error", idx[0], idx[1])
+		}
+	}
+}
+
+func TestNewEmptyMatrix_Contiguous(t *testing.T) {
+	// One allocation for the elements and one for the row headers
+	allocs := testing.AllocsPerRun(100, func() {
+		_, _ = NewEmptyMatrix(50, 40)
+	})
+	if allocs > 3 {
+		t.Errorf("NewEmptyMatrix(50, 40) made %v allocations, expected at most 3", allocs)
+	}
+}
+
+func BenchmarkMultiplyDense(b *testing.B) {
+	sizes := []int{50, 100, 200}
+
+	for _, size := range sizes {
+		b.Run(fmt.Sprintf("%dx%d", size, size), func(b *testing.B) {
+			A, _ := NewDenseFromMatrix(randomFloatMatrix(size, size))
+			B, _ := NewDenseFromMatrix(randomFloatMatrix(size, size))
+			a, bm := A.Matrix(), B.Matrix()
+			b.ResetTimer()
+			for b.Loop() {
+				_, _ = Multiply(a, bm)
+			}
+		})
+	}
+}
@@ -1,7 +1,9 @@
 package matrix
 
 import (
+	"errors"
 	"math"
+	"math/big"
 	"testing"
 )
 
@@ -165,6 +167,114 @@ func TestDeterminants(t *testing.T) {
 	}
 }
 
+func TestDetExact(t *testing.T) {
+	// Vandermonde matrix of 1..12, whose determinant is the superfactorial
+	// 1!·2!·…·11!, far beyond the range of int and of exact float64
+	vandermonde := make(Matrix[int], 12)
+	superfactorial := big.NewInt(1)
+	factorial := big.NewInt(1)
+	for i := range vandermonde {
+		vandermonde[i] = make([]int, 12)
+		p := 1
+		for j := range vandermonde[i] {
+			vandermonde[i][j] = p
+			p *= i + 1
+		}
+		if i > 0 {
+			factorial.Mul(factorial, big.NewInt(int64(i)))
+			superfactorial.Mul(superfactorial, factorial)
+		}
+	}
+
+	tests := []struct {
+		name   string
+		matrix Matrix[int]
+		want   *big.Int
+	}{
+		{name: "1x1 matrix", matrix: Matrix[int]{{-7}}, want: big.NewInt(-7)},
+		{name: "2x2 standard", matrix: Matrix[int]{{3, 8}, {4, 6}}, want: big.NewInt(-14)},
+		{name: "Zero pivot needs a swap", matrix: Matrix[int]{{0, 1}, {1, 0}}, want: big.NewInt(-1)},
+		{name: "Two swaps", matrix: Matrix[int]{{0, 0, 2}, {0, 3, 0}, {5, 0, 0}}, want: big.NewInt(-30)},
+		{name: "Singular", matrix: Matrix[int]{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}}, want: big.NewInt(0)},
+		{name: "Zero column", matrix: Matrix[int]{{0, 1}, {0, 2}}, want: big.NewInt(0)},
+		{
+			name:   "5x5 matrix",
+			matrix: Matrix[int]{{2, 3, -1, 3, 3}, {0, -1, 5, 2, -1}, {0, 0, 3, 9, 2}, {0, 0, 0, -1, 3}, {0, 0, 0, 0, 5}},
+			want:   big.NewInt(30),
+		},
+		{
+			// Float elimination loses the last digits of these entries
+			name:   "Large entries",
+			matrix: Matrix[int]{{1 << 40, 1}, {1, 1 << 40}},
+			want:   new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 80), big.NewInt(1)),
+		},
+		{name: "Vandermonde 12x12", matrix: vandermonde, want: superfactorial},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DetExact(tt.matrix)
+			if err != nil {
+				t.Fatalf("DetExact() error = %v", err)
+			}
+			if got.Cmp(tt.want) != 0 {
+				t.Errorf("DetExact() = %s; want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDetExact_Errors(t *testing.T) {
+	if _, err := DetExact(Matrix[int]{}); !errors.Is(err, ErrEmpty) {
+		t.Errorf("expected ErrEmpty, got %v", err)
+	}
+	if _, err := DetExact(Matrix[int]{{1, 2, 3}, {4, 5, 6}}); !errors.Is(err, ErrNotSquare) {
+		t.Errorf("expected ErrNotSquare, got %v", err)
+	}
+	if _, err := DetExact(Matrix[int]{{1, 2}, {3}}); err == nil {
+		t.Error("expected error for improper matrix")
+	}
+}
+
+func TestDetInt(t *testing.T) {
+	tests := []struct {
+		name    string
+		matrix  Matrix[int]
+		want    int
+		wantErr error
+	}{
+		{name: "3x3 standard", matrix: Matrix[int]{{3, 2, 4}, {2, 0, 2}, {4, 2, 3}}, want: 8},
+		{name: "MaxInt", matrix: Matrix[int]{{math.MaxInt, 0}, {0, 1}}, want: math.MaxInt},
+		{name: "MinInt", matrix: Matrix[int]{{0, math.MinInt}, {1, 0}}, wantErr: ErrOverflow},
+		{
+			// Intermediate values overflow but the determinant fits
+			name:   "Large intermediates",
+			matrix: Matrix[int]{{1 << 40, 1 << 40}, {1 << 40, 1<<40 + 1}},
+			want:   1 << 40,
+		},
+		{name: "Overflow", matrix: Matrix[int]{{1 << 40, 0}, {0, 1 << 40}}, wantErr: ErrOverflow},
+		{name: "Non-square", matrix: Matrix[int]{{1, 2}}, wantErr: ErrNotSquare},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DetInt(tt.matrix)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("DetInt() error = %v; want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("DetInt() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("DetInt() = %d; want %d", got, tt.want)
+			}
+		})
+	}
+}
+
 func BenchmarkDet2x2(b *testing.B) {
 	m := Matrix[int]{
 		{4, 7},
@@ -181,3 +291,11 @@ func BenchmarkDet10x10(b *testing.B) {
 		_, _ = Det(m)
 	}
 }
+
+func BenchmarkDetExact(b *testing.B) {
+	m := randomIntMatrix(50, 50)
+	b.ResetTimer()
+	for b.Loop() {
+		_, _ = DetExact(m)
+	}
+}
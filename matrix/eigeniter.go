@@ -0,0 +1,300 @@
+package matrix
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+
+	"github.com/rickykimani/linalg/vectors"
+)
+
+// EigenPair is a single eigenvalue and eigenvector found by one of the
+// iterative eigen methods, together with convergence information.
+type EigenPair struct {
+	Value      float64                 // Eigenvalue estimate λ, the Rayleigh quotient vᵀ·A·v
+	Vector     vectors.Vector[float64] // Unit eigenvector estimate v, with its largest component positive
+	Iterations int                     // Number of iterations performed
+	Residual   float64                 // Residual norm ‖A·v - λ·v‖
+	Converged  bool                    // Whether the residual reached the requested tolerance
+}
+
+// PowerIteration finds the eigenvalue of largest magnitude and its
+// eigenvector with the power method.
+//
+// Parameters:
+//   - m: Square input matrix of type Matrix[T] where T is int or float64
+//   - maxIter: Maximum number of iterations, which must be positive
+//   - tol: Convergence tolerance; iteration stops once ‖A·v - λ·v‖ ≤ tol·‖A‖_F
+//
+// Returns:
+//   - EigenPair: The eigenpair estimate with its iteration count and residual
+//   - error: Returns error if the matrix is invalid, empty or non-square, or the
+//     parameters are out of range (ErrInvalidArgument)
+//
+// Each iteration costs one matrix-vector product, so the method suits large
+// matrices where only the dominant eigenpair is wanted. Convergence is linear
+// with rate |λ₂/λ₁|; it is slow when the two largest eigenvalues are close in
+// magnitude and fails when they have equal magnitude, for example a complex
+// conjugate pair. Failing to converge is not an error: Converged reports
+// false and the last estimate is returned.
+//
+// The starting vector is pseudo-random with a fixed seed, so results are
+// reproducible.
+//
+// Time complexity: O(n²) per iteration where n is the matrix dimension.
+func PowerIteration[T int | float64](m Matrix[T], maxIter int, tol float64) (EigenPair, error) {
+	it, err := newEigenIteration(m, maxIter, tol)
+	if err != nil {
+		return EigenPair{}, err
+	}
+
+	return it.run(func() error {
+		it.setNormalized(it.av)
+		return nil
+	})
+}
+
+// InverseIteration finds the eigenvalue closest to a shift and its
+// eigenvector by applying the power method to (A - σI)⁻¹.
+//
+// Parameters:
+//   - m: Square input matrix of type Matrix[T] where T is int or float64
+//   - shift: The shift σ, an estimate of the wanted eigenvalue
+//   - maxIter: Maximum number of iterations, which must be positive
+//   - tol: Convergence tolerance; iteration stops once ‖A·v - λ·v‖ ≤ tol·‖A‖_F
+//
+// Returns:
+//   - EigenPair: The eigenpair estimate with its iteration count and residual
+//   - error: Returns error if the matrix is invalid, empty or non-square, the
+//     parameters are out of range (ErrInvalidArgument), or A - σI stays
+//     singular after the shift is perturbed (ErrSingular)
+//
+// A - σI is factorized once with LU decomposition and every iteration is a
+// pair of triangular solves. Convergence is linear with rate |λ - σ|/|λ' - σ|,
+// where λ' is the second-closest eigenvalue, so a good shift converges in a
+// few iterations. A shift that is exactly an eigenvalue is perturbed slightly,
+// since A - σI is then singular.
+//
+// Time complexity: O(n³) for the factorization plus O(n²) per iteration.
+func InverseIteration[T int | float64](m Matrix[T], shift float64, maxIter int, tol float64) (EigenPair, error) {
+	it, err := newEigenIteration(m, maxIter, tol)
+	if err != nil {
+		return EigenPair{}, err
+	}
+
+	if math.IsNaN(shift) || math.IsInf(shift, 0) {
+		return EigenPair{}, fmt.Errorf("shift must be finite: %w", ErrInvalidArgument)
+	}
+
+	lu, err := it.shiftedLU(shift)
+	if err != nil {
+		return EigenPair{}, err
+	}
+	return it.run(func() error {
+		w, _ := lu.SolveVector(it.v)
+		it.setNormalized(w)
+		return nil
+	})
+}
+
+// RayleighQuotientIteration refines an eigenpair with inverse iteration whose
+// shift is updated to the current Rayleigh quotient at every step.
+//
+// Parameters:
+//   - m: Square input matrix of type Matrix[T] where T is int or float64
+//   - shift: Initial estimate of the wanted eigenvalue, used for the first step
+//   - maxIter: Maximum number of iterations, which must be positive
+//   - tol: Convergence tolerance; iteration stops once ‖A·v - λ·v‖ ≤ tol·‖A‖_F
+//
+// Returns:
+//   - EigenPair: The eigenpair estimate with its iteration count and residual
+//   - error: Returns error if the matrix is invalid, empty or non-square, the
+//     parameters are out of range (ErrInvalidArgument), or a shifted matrix
+//     A - μI stays singular after the shift is perturbed (ErrSingular)
+//
+// Convergence is cubic for symmetric matrices and quadratic otherwise, so a
+// handful of iterations usually reach machine precision. The price is a new
+// LU factorization every iteration, and the eigenvalue found is not
+// guaranteed to be the one closest to the initial shift. A common pattern is
+// to obtain a rough estimate with PowerIteration or InverseIteration and
+// polish it here.
+//
+// Time complexity: O(n³) per iteration where n is the matrix dimension.
+func RayleighQuotientIteration[T int | float64](m Matrix[T], shift float64, maxIter int, tol float64) (EigenPair, error) {
+	it, err := newEigenIteration(m, maxIter, tol)
+	if err != nil {
+		return EigenPair{}, err
+	}
+
+	if math.IsNaN(shift) || math.IsInf(shift, 0) {
+		return EigenPair{}, fmt.Errorf("shift must be finite: %w", ErrInvalidArgument)
+	}
+
+	mu := shift
+	return it.run(func() error {
+		lu, err := it.shiftedLU(mu)
+		if err != nil {
+			return err
+		}
+		w, _ := lu.SolveVector(it.v)
+		it.setNormalized(w)
+		mu = it.value
+		return nil
+	})
+}
+
+// eigenIteration holds the state shared by the iterative eigen methods.
+type eigenIteration struct {
+	a       Matrix[float64]
+	norm    float64 // Frobenius norm of a
+	v, av   []float64
+	value   float64
+	limit   float64 // Residual at which the iteration has converged
+	maxIter int
+}
+
+// newEigenIteration validates the arguments and prepares a unit starting vector.
+func newEigenIteration[T int | float64](m Matrix[T], maxIter int, tol float64) (*eigenIteration, error) {
+	if err := m.Validate(); err != nil {
+		return nil, err
+	}
+
+	n := len(m)
+	if n == 0 {
+		return nil, ErrEmpty
+	}
+	if !m.isSquare() {
+		return nil, ErrNotSquare
+	}
+	if maxIter <= 0 {
+		return nil, fmt.Errorf("maxIter must be positive: %w", ErrInvalidArgument)
+	}
+	if tol < 0 || math.IsNaN(tol) {
+		return nil, fmt.Errorf("tolerance cannot be negative: %w", ErrInvalidArgument)
+	}
+
+	it := &eigenIteration{
+		a:       gtoFloat64Matrix(m),
+		av:      make([]float64, n),
+		maxIter: maxIter,
+	}
+	for _, row := range it.a {
+		for _, x := range row {
+			it.norm = math.Hypot(it.norm, x)
+		}
+	}
+	it.limit = tol * it.norm
+
+	// A fixed pseudo-random start is very unlikely to be orthogonal to the
+	// wanted eigenvector, unlike simple choices such as the all-ones vector
+	rng := rand.New(rand.NewPCG(1, 2))
+	start := make([]float64, n)
+	for i := range start {
+		start[i] = rng.Float64() + 0.5
+	}
+	it.setNormalized(start)
+
+	return it, nil
+}
+
+// run repeats step, which must replace v with a new unit vector, until the
+// residual of the current estimate converges or maxIter steps have been taken.
+// An error from step ends the iteration and is returned.
+func (it *eigenIteration) run(step func() error) (EigenPair, error) {
+	residual := it.update()
+	iter := 0
+	for residual > it.limit && iter < it.maxIter {
+		if err := step(); err != nil {
+			return EigenPair{}, err
+		}
+		residual = it.update()
+		iter++
+	}
+
+	// Fix the sign so that the largest component is positive
+	v := make(vectors.Vector[float64], len(it.v))
+	copy(v, it.v)
+	big := 0
+	for i := range v {
+		if math.Abs(v[i]) > math.Abs(v[big]) {
+			big = i
+		}
+	}
+	if v[big] < 0 {
+		for i := range v {
+			v[i] = -v[i]
+		}
+	}
+
+	return EigenPair{
+		Value:      it.value,
+		Vector:     v,
+		Iterations: iter,
+		Residual:   residual,
+		Converged:  residual <= it.limit,
+	}, nil
+}
+
+// update computes A·v and the Rayleigh quotient of v, and returns the
+// residual norm ‖A·v - λ·v‖.
+func (it *eigenIteration) update() float64 {
+	it.value = 0
+	for i, row := range it.a {
+		s := 0.0
+		for j, x := range row {
+			s += x * it.v[j]
+		}
+		it.av[i] = s
+		it.value += it.v[i] * s
+	}
+
+	residual := 0.0
+	for i := range it.v {
+		residual = math.Hypot(residual, it.av[i]-it.value*it.v[i])
+	}
+	return residual
+}
+
+// setNormalized sets v to w scaled to unit length. A zero w leaves v unchanged.
+func (it *eigenIteration) setNormalized(w []float64) {
+	nrm := 0.0
+	for _, x := range w {
+		nrm = math.Hypot(nrm, x)
+	}
+	if nrm == 0 {
+		return
+	}
+
+	v := make([]float64, len(w))
+	for i, x := range w {
+		v[i] = x / nrm
+	}
+	it.v = v
+}
+
+// maxShiftAttempts is the number of shifts shiftedLU tries before giving up.
+const maxShiftAttempts = 4
+
+// shiftedLU factorizes A - σI. A shift that makes the matrix singular is
+// moved by a small relative amount, which keeps inverse iteration well defined
+// while still amplifying the eigenvector enormously. If A - σI is still
+// singular after maxShiftAttempts shifts, ErrSingular is returned.
+func (it *eigenIteration) shiftedLU(shift float64) (*LU, error) {
+	delta := math.Sqrt(machEps) * max(it.norm, math.Abs(shift), 1)
+	for range maxShiftAttempts {
+		shifted := cloneMatrix(it.a)
+		for i := range shifted {
+			shifted[i][i] -= shift
+		}
+		lu, err := NewLU(shifted)
+		if err != nil {
+			return nil, err
+		}
+		if !lu.IsSingular() {
+			return lu, nil
+		}
+		shift += delta
+		delta *= 2
+	}
+	return nil, ErrSingular
+}
@@ -0,0 +1,203 @@
+package matrix
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+)
+
+// checkEigenPair verifies the reported residual of an eigenpair against A.
+func checkEigenPair(t *testing.T, A Matrix[float64], p EigenPair) {
+	t.Helper()
+
+	Av, _ := MultiplyVector(A, p.Vector)
+	residual := 0.0
+	for i := range Av {
+		residual = math.Hypot(residual, Av[i]-p.Value*p.Vector[i])
+	}
+	if math.Abs(residual-p.Residual) > 1e-12*math.Max(1, residual) {
+		t.Errorf("reported residual %g, actual %g", p.Residual, residual)
+	}
+
+	norm := 0.0
+	for _, x := range p.Vector {
+		norm = math.Hypot(norm, x)
+	}
+	if math.Abs(norm-1) > 1e-12 {
+		t.Errorf("eigenvector is not unit length: %g", norm)
+	}
+}
+
+func TestPowerIteration(t *testing.T) {
+	tests := []struct {
+		name      string
+		matrix    Matrix[float64]
+		expected  float64
+		converged bool
+	}{
+		{
+			name:      "Symmetric 2x2",
+			matrix:    Matrix[float64]{{2, 1}, {1, 2}},
+			expected:  3,
+			converged: true,
+		},
+		{
+			name:      "Dominant negative eigenvalue",
+			matrix:    Matrix[float64]{{-5, 0, 0}, {0, 2, 0}, {0, 0, 1}},
+			expected:  -5,
+			converged: true,
+		},
+		{
+			name:      "Nonsymmetric",
+			matrix:    Matrix[float64]{{4, 1}, {2, 3}},
+			expected:  5,
+			converged: true,
+		},
+		{
+			name:      "Zero matrix",
+			matrix:    Matrix[float64]{{0, 0}, {0, 0}},
+			expected:  0,
+			converged: true,
+		},
+		{
+			// The eigenvalues ±i have equal magnitude, so the iteration cycles
+			name:      "Rotation does not converge",
+			matrix:    Matrix[float64]{{0, -1}, {1, 0}},
+			expected:  0,
+			converged: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := PowerIteration(tt.matrix, 1000, 1e-12)
+			if err != nil {
+				t.Fatalf("PowerIteration() error = %v", err)
+			}
+			if p.Converged != tt.converged {
+				t.Fatalf("Converged = %v, expected %v (residual %g)", p.Converged, tt.converged, p.Residual)
+			}
+			if p.Iterations > 1000 {
+				t.Errorf("Iterations = %d exceeds maxIter", p.Iterations)
+			}
+			if tt.converged && math.Abs(p.Value-tt.expected) > 1e-9 {
+				t.Errorf("Value = %v, expected %v", p.Value, tt.expected)
+			}
+			checkEigenPair(t, tt.matrix, p)
+		})
+	}
+}
+
+func TestInverseIteration(t *testing.T) {
+	A := Matrix[float64]{
+		{4, 1, 0},
+		{1, 3, 1},
+		{0, 1, 2},
+	}
+	vals, _, _ := EigenSym(A)
+
+	for i, shift := range []float64{1, 3, 5} {
+		t.Run(fmt.Sprintf("shift=%g", shift), func(t *testing.T) {
+			p, err := InverseIteration(A, shift, 100, 1e-13)
+			if err != nil {
+				t.Fatalf("InverseIteration() error = %v", err)
+			}
+			if !p.Converged {
+				t.Fatalf("did not converge, residual %g", p.Residual)
+			}
+			if math.Abs(p.Value-vals[i]) > 1e-10 {
+				t.Errorf("Value = %v, expected %v", p.Value, vals[i])
+			}
+			checkEigenPair(t, A, p)
+		})
+	}
+
+	// A shift equal to an eigenvalue makes A - σI singular
+	p, err := InverseIteration(Matrix[int]{{2, 0}, {0, 7}}, 7, 50, 1e-12)
+	if err != nil {
+		t.Fatalf("InverseIteration() error = %v", err)
+	}
+	if !p.Converged || math.Abs(p.Value-7) > 1e-10 {
+		t.Errorf("exact shift: got %+v, expected eigenvalue 7", p)
+	}
+}
+
+func TestRayleighQuotientIteration(t *testing.T) {
+	R := randomFloatMatrix(20, 20)
+	A, _ := Add(R, Transpose(R))
+	vals, _, _ := EigenSym(A)
+
+	p, err := RayleighQuotientIteration(A, vals[5]+0.01, 50, 1e-14)
+	if err != nil {
+		t.Fatalf("RayleighQuotientIteration() error = %v", err)
+	}
+	if !p.Converged {
+		t.Fatalf("did not converge, residual %g", p.Residual)
+	}
+	if p.Iterations > 10 {
+		t.Errorf("expected fast convergence, took %d iterations", p.Iterations)
+	}
+	found := false
+	for _, v := range vals {
+		if math.Abs(p.Value-v) < 1e-10 {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("Value = %v is not an eigenvalue", p.Value)
+	}
+	checkEigenPair(t, A, p)
+}
+
+func TestEigenIteration_Errors(t *testing.T) {
+	A := Matrix[int]{{1, 2}, {3, 4}}
+	tests := []struct {
+		name string
+		fn   func() error
+	}{
+		{"Empty matrix", func() error { _, err := PowerIteration(Matrix[int]{}, 10, 1e-10); return err }},
+		{"Non-square matrix", func() error { _, err := InverseIteration(Matrix[int]{{1, 2}}, 0, 10, 1e-10); return err }},
+		{"Zero maxIter", func() error { _, err := PowerIteration(A, 0, 1e-10); return err }},
+		{"Negative tolerance", func() error { _, err := RayleighQuotientIteration(A, 0, 10, -1); return err }},
+		{"NaN shift", func() error { _, err := InverseIteration(A, math.NaN(), 10, 1e-10); return err }},
+		{"Infinite shift", func() error { _, err := RayleighQuotientIteration(A, math.Inf(1), 10, 1e-10); return err }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.fn(); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
+
+func TestShiftedLU_Singular(t *testing.T) {
+	// With the norm understated, the perturbation of the shift stays far below
+	// the singularity threshold of A - σI and every attempt fails
+	it := &eigenIteration{a: Matrix[float64]{{1e20, 0}, {0, 0}}}
+	if _, err := it.shiftedLU(0); !errors.Is(err, ErrSingular) {
+		t.Errorf("expected ErrSingular, got %v", err)
+	}
+
+	// With the true norm a single perturbation suffices
+	it.norm = 1e20
+	if _, err := it.shiftedLU(0); err != nil {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func BenchmarkPowerIteration(b *testing.B) {
+	sizes := []int{10, 50, 100}
+
+	for _, size := range sizes {
+		b.Run(fmt.Sprintf("%dx%d", size, size), func(b *testing.B) {
+			A := randomFloatMatrix(size, size)
+			b.ResetTimer()
+			for b.Loop() {
+				_, _ = PowerIteration(A, 1000, 1e-12)
+			}
+		})
+	}
+}
@@ -0,0 +1,271 @@
+package matrix
+
+import (
+	"fmt"
+	"math"
+)
+
+// eigenMaxSweeps bounds the number of implicit QL or QR iterations spent on a
+// single eigenvalue before the computation is declared to have failed.
+const eigenMaxSweeps = 30
+
+// EigenSym computes all eigenvalues and eigenvectors of a symmetric matrix.
+//
+// Parameters:
+//   - m: Symmetric input matrix of type Matrix[T] where T is int or float64
+//   - tol: Optional tolerance for the symmetry check, as for NewCholesky
+//
+// Returns:
+//   - []float64: The eigenvalues in ascending order
+//   - Matrix[float64]: Orthogonal matrix V whose column j is the unit eigenvector
+//     belonging to eigenvalue j, so that A·V = V·diag(λ)
+//   - error: Returns error if the matrix is invalid, empty, non-square, has a
+//     NaN or infinite element (ErrInvalidArgument) or is not symmetric
+//     (ErrNotSymmetric), or if the iteration fails to converge
+//
+// The matrix is first reduced to symmetric tridiagonal form with Householder
+// similarity transformations, after which the tridiagonal matrix is
+// diagonalized with the implicitly shifted QL algorithm. All eigenvalues of a
+// symmetric matrix are real and the eigenvectors are orthonormal to machine
+// precision, even for repeated eigenvalues.
+//
+// The sign of each eigenvector is arbitrary.
+//
+// Example:
+//
+//	A := Matrix[int]{{2, 1}, {1, 2}}
+//	vals, vecs, _ := EigenSym(A)  // vals = [1, 3], vecs columns ±[1, -1]/√2 and ±[1, 1]/√2
+//
+// Time complexity: O(n³) where n is the matrix dimension.
+func EigenSym[T int | float64](m Matrix[T], tol ...Tolerance) ([]float64, Matrix[float64], error) {
+	if err := m.Validate(); err != nil {
+		return nil, nil, err
+	}
+
+	n := len(m)
+	if n == 0 {
+		return nil, nil, ErrEmpty
+	}
+
+	if !m.isSquare() {
+		return nil, nil, ErrNotSquare
+	}
+
+	if err := checkFinite(m); err != nil {
+		return nil, nil, err
+	}
+
+	if !m.isSymmetric(tol) {
+		return nil, nil, ErrNotSymmetric
+	}
+
+	v := gtoFloat64Matrix(m)
+	d := make([]float64, n)
+	e := make([]float64, n)
+
+	tridiagonalize(v, d, e)
+	if err := tridiagonalQL(v, d, e); err != nil {
+		return nil, nil, err
+	}
+
+	return d, v, nil
+}
+
+// tridiagonalize reduces the symmetric matrix v to tridiagonal form with
+// Householder transformations, following the EISPACK routine TRED2.
+//
+// On return d holds the diagonal, e[1:] the subdiagonal, and v the orthogonal
+// matrix that accumulates the transformations.
+func tridiagonalize(v Matrix[float64], d, e []float64) {
+	n := len(v)
+	copy(d, v[n-1])
+
+	for i := n - 1; i > 0; i-- {
+		// Scale to avoid under/overflow
+		scale, h := 0.0, 0.0
+		for k := range i {
+			scale += math.Abs(d[k])
+		}
+
+		if scale == 0 {
+			e[i] = d[i-1]
+			for j := range i {
+				d[j] = v[i-1][j]
+				v[i][j] = 0
+				v[j][i] = 0
+			}
+		} else {
+			// Generate the Householder vector
+			for k := range i {
+				d[k] /= scale
+				h += d[k] * d[k]
+			}
+			f := d[i-1]
+			g := math.Sqrt(h)
+			if f > 0 {
+				g = -g
+			}
+			e[i] = scale * g
+			h -= f * g
+			d[i-1] = f - g
+			for j := range i {
+				e[j] = 0
+			}
+
+			// Apply the similarity transformation to the remaining columns
+			for j := range i {
+				f = d[j]
+				v[j][i] = f
+				g = e[j] + v[j][j]*f
+				for k := j + 1; k <= i-1; k++ {
+					g += v[k][j] * d[k]
+					e[k] += v[k][j] * f
+				}
+				e[j] = g
+			}
+			f = 0
+			for j := range i {
+				e[j] /= h
+				f += e[j] * d[j]
+			}
+			hh := f / (h + h)
+			for j := range i {
+				e[j] -= hh * d[j]
+			}
+			for j := range i {
+				f = d[j]
+				g = e[j]
+				for k := j; k <= i-1; k++ {
+					v[k][j] -= f*e[k] + g*d[k]
+				}
+				d[j] = v[i-1][j]
+				v[i][j] = 0
+			}
+		}
+		d[i] = h
+	}
+
+	// Accumulate the transformations
+	for i := range n - 1 {
+		v[n-1][i] = v[i][i]
+		v[i][i] = 1
+		h := d[i+1]
+		if h != 0 {
+			for k := 0; k <= i; k++ {
+				d[k] = v[k][i+1] / h
+			}
+			for j := 0; j <= i; j++ {
+				g := 0.0
+				for k := 0; k <= i; k++ {
+					g += v[k][i+1] * v[k][j]
+				}
+				for k := 0; k <= i; k++ {
+					v[k][j] -= g * d[k]
+				}
+			}
+		}
+		for k := 0; k <= i; k++ {
+			v[k][i+1] = 0
+		}
+	}
+	for j := range n {
+		d[j] = v[n-1][j]
+		v[n-1][j] = 0
+	}
+	v[n-1][n-1] = 1
+	e[0] = 0
+}
+
+// tridiagonalQL diagonalizes the symmetric tridiagonal matrix given by d and
+// e (as produced by tridiagonalize) with the implicitly shifted QL algorithm,
+// following the EISPACK routine TQL2.
+//
+// On return d holds the eigenvalues in ascending order and the columns of v
+// have been rotated into the corresponding eigenvectors.
+func tridiagonalQL(v Matrix[float64], d, e []float64) error {
+	n := len(v)
+	for i := 1; i < n; i++ {
+		e[i-1] = e[i]
+	}
+	e[n-1] = 0
+
+	f, tst1 := 0.0, 0.0
+	for l := range n {
+		// Find a small subdiagonal element
+		tst1 = max(tst1, math.Abs(d[l])+math.Abs(e[l]))
+		m := l
+		for m < n && math.Abs(e[m]) > machEps*tst1 {
+			m++
+		}
+
+		// If m == l, d[l] is already an eigenvalue; otherwise iterate
+		for iter := 0; m > l && math.Abs(e[l]) > machEps*tst1; iter++ {
+			if iter == eigenMaxSweeps {
+				return fmt.Errorf("eigenvalue iteration: %w", ErrNoConvergence)
+			}
+
+			// Compute the implicit shift
+			g := d[l]
+			p := (d[l+1] - g) / (2 * e[l])
+			r := math.Hypot(p, 1)
+			if p < 0 {
+				r = -r
+			}
+			d[l] = e[l] / (p + r)
+			d[l+1] = e[l] * (p + r)
+			dl1 := d[l+1]
+			h := g - d[l]
+			for i := l + 2; i < n; i++ {
+				d[i] -= h
+			}
+			f += h
+
+			// Implicit QL transformation
+			p = d[m]
+			c, c2, c3 := 1.0, 1.0, 1.0
+			el1 := e[l+1]
+			s, s2 := 0.0, 0.0
+			for i := m - 1; i >= l; i-- {
+				c3 = c2
+				c2 = c
+				s2 = s
+				g = c * e[i]
+				h = c * p
+				r = math.Hypot(p, e[i])
+				e[i+1] = s * r
+				s = e[i] / r
+				c = p / r
+				p = c*d[i] - s*g
+				d[i+1] = h + s*(c*g+s*d[i])
+
+				// Accumulate the transformation
+				for k := range n {
+					h = v[k][i+1]
+					v[k][i+1] = s*v[k][i] + c*h
+					v[k][i] = c*v[k][i] - s*h
+				}
+			}
+			p = -s * s2 * c3 * el1 * e[l] / dl1
+			e[l] = s * p
+			d[l] = c * p
+		}
+		d[l] += f
+		e[l] = 0
+	}
+
+	// Sort the eigenvalues and corresponding vectors
+	for i := range n - 1 {
+		k := i
+		for j := i + 1; j < n; j++ {
+			if d[j] < d[k] {
+				k = j
+			}
+		}
+		if k != i {
+			d[i], d[k] = d[k], d[i]
+			swapColumns(v, i, k)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,154 @@
+package matrix
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestEigenSym(t *testing.T) {
+	tests := []struct {
+		name  string
+		input Matrix[float64]
+		want  []float64
+	}{
+		{
+			name:  "1x1",
+			input: Matrix[float64]{{-4}},
+			want:  []float64{-4},
+		},
+		{
+			name: "2x2 symmetric",
+			input: Matrix[float64]{
+				{2, 1},
+				{1, 2},
+			},
+			want: []float64{1, 3},
+		},
+		{
+			name: "diagonal unsorted",
+			input: Matrix[float64]{
+				{5, 0, 0},
+				{0, -1, 0},
+				{0, 0, 2},
+			},
+			want: []float64{-1, 2, 5},
+		},
+		{
+			name: "repeated eigenvalue",
+			input: Matrix[float64]{
+				{2, 0, 0},
+				{0, 3, 1},
+				{0, 1, 3},
+			},
+			want: []float64{2, 2, 4},
+		},
+		{
+			name: "indefinite tridiagonal",
+			input: Matrix[float64]{
+				{2, -1, 0, 0},
+				{-1, 2, -1, 0},
+				{0, -1, 2, -1},
+				{0, 0, -1, 2},
+			},
+			want: []float64{
+				2 - 2*math.Cos(math.Pi/5),
+				2 - 2*math.Cos(2*math.Pi/5),
+				2 - 2*math.Cos(3*math.Pi/5),
+				2 - 2*math.Cos(4*math.Pi/5),
+			},
+		},
+		{
+			name:  "zero matrix",
+			input: Matrix[float64]{{0, 0}, {0, 0}},
+			want:  []float64{0, 0},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vals, vecs, err := EigenSym(tt.input)
+			if err != nil {
+				t.Fatalf("EigenSym() error = %v", err)
+			}
+			if !rowsAlmostEqual(vals, tt.want, 1e-12) {
+				t.Errorf("eigenvalues = %v, want %v", vals, tt.want)
+			}
+			checkEigenSym(t, tt.input, vals, vecs)
+		})
+	}
+}
+
+func TestEigenSym_Random(t *testing.T) {
+	for _, n := range []int{5, 20, 60} {
+		t.Run(fmt.Sprintf("%dx%d", n, n), func(t *testing.T) {
+			R := randomFloatMatrix(n, n)
+			A, _ := Add(R, Transpose(R))
+
+			vals, vecs, err := EigenSym(A)
+			if err != nil {
+				t.Fatalf("EigenSym() error = %v", err)
+			}
+			for i := 1; i < n; i++ {
+				if vals[i] < vals[i-1] {
+					t.Fatalf("eigenvalues not sorted: %v", vals)
+				}
+			}
+			checkEigenSym(t, A, vals, vecs)
+		})
+	}
+}
+
+// checkEigenSym verifies that V is orthogonal and A·V = V·diag(λ).
+func checkEigenSym(t *testing.T, A Matrix[float64], vals []float64, V Matrix[float64]) {
+	t.Helper()
+	n := len(A)
+
+	VtV, _ := Multiply(Transpose(V), V)
+	if !matricesAlmostEqual(VtV, Identity(n), 1e-13) {
+		t.Errorf("VᵀV != I\n%s", matrixToString(VtV))
+	}
+
+	AV, _ := Multiply(A, V)
+	scale := math.Max(1, math.Abs(vals[0])+math.Abs(vals[n-1]))
+	for i := range n {
+		for j := range n {
+			if math.Abs(AV[i][j]-V[i][j]*vals[j]) > 1e-12*scale {
+				t.Fatalf("A·V != V·Λ at [%d][%d]: %g vs %g", i, j, AV[i][j], V[i][j]*vals[j])
+			}
+		}
+	}
+}
+
+func TestEigenSym_Errors(t *testing.T) {
+	if _, _, err := EigenSym(Matrix[int]{{1, 2}, {3, 4}}); !errors.Is(err, ErrNotSymmetric) {
+		t.Errorf("expected ErrNotSymmetric, got %v", err)
+	}
+	if _, _, err := EigenSym(Matrix[int]{}); err == nil {
+		t.Error("expected error for empty matrix")
+	}
+	if _, _, err := EigenSym(Matrix[int]{{1, 2, 3}}); err == nil {
+		t.Error("expected error for non-square matrix")
+	}
+	for _, v := range []float64{math.NaN(), math.Inf(1), math.Inf(-1)} {
+		if _, _, err := EigenSym(Matrix[float64]{{1, v}, {v, 4}}); !errors.Is(err, ErrInvalidArgument) {
+			t.Errorf("expected ErrInvalidArgument for element %g, got %v", v, err)
+		}
+	}
+}
+
+func BenchmarkEigenSym(b *testing.B) {
+	sizes := []int{10, 50, 100}
+
+	for _, size := range sizes {
+		b.Run(fmt.Sprintf("%dx%d", size, size), func(b *testing.B) {
+			R := randomFloatMatrix(size, size)
+			A, _ := Add(R, Transpose(R))
+			b.ResetTimer()
+			for b.Loop() {
+				_, _, _ = EigenSym(A)
+			}
+		})
+	}
+}
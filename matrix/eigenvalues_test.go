@@ -1,7 +1,10 @@
 package matrix
 
 import (
+	"errors"
+	"fmt"
 	"math"
+	"math/cmplx"
 	"testing"
 )
 
@@ -33,6 +36,16 @@ func TestEigenvaluesQR(t *testing.T) {
 			expected:  []float64{3, 1},
 			wantError: false,
 		},
+		{
+			name: "Singular matrix",
+			matrix: Matrix[float64]{
+				{1, 2},
+				{2, 4},
+			},
+			maxIter:  200,
+			tol:      1e-14,
+			expected: []float64{5, 0},
+		},
 		{
 			name:      "Empty matrix",
 			matrix:    Matrix[float64]{},
@@ -100,3 +113,183 @@ func TestEigenvaluesQR(t *testing.T) {
 		})
 	}
 }
+
+func TestEigenvalues(t *testing.T) {
+	tests := []struct {
+		name     string
+		matrix   Matrix[float64]
+		expected []complex128
+	}{
+		{
+			name:     "1x1 matrix",
+			matrix:   Matrix[float64]{{-3}},
+			expected: []complex128{-3},
+		},
+		{
+			name:     "Rotation by 90 degrees",
+			matrix:   Matrix[float64]{{0, -1}, {1, 0}},
+			expected: []complex128{-1i, 1i},
+		},
+		{
+			name:     "Scaled rotation",
+			matrix:   Matrix[float64]{{1, -2}, {2, 1}},
+			expected: []complex128{1 - 2i, 1 + 2i},
+		},
+		{
+			name:     "Symmetric matrix",
+			matrix:   Matrix[float64]{{2, 1}, {1, 2}},
+			expected: []complex128{1, 3},
+		},
+		{
+			name:     "Upper triangular",
+			matrix:   Matrix[float64]{{4, 1, 2}, {0, -1, 3}, {0, 0, 2}},
+			expected: []complex128{-1, 2, 4},
+		},
+		{
+			name:     "Zero matrix",
+			matrix:   Matrix[float64]{{0, 0, 0}, {0, 0, 0}, {0, 0, 0}},
+			expected: []complex128{0, 0, 0},
+		},
+		{
+			name:     "Jordan block",
+			matrix:   Matrix[float64]{{2, 1}, {0, 2}},
+			expected: []complex128{2, 2},
+		},
+		{
+			// Companion matrix of (x - 1)(x² + 1) = x³ - x² + x - 1
+			name:     "Companion matrix with real and complex roots",
+			matrix:   Matrix[float64]{{1, -1, 1}, {1, 0, 0}, {0, 1, 0}},
+			expected: []complex128{-1i, 1i, 1},
+		},
+		{
+			name: "Cyclic permutation",
+			matrix: Matrix[float64]{
+				{0, 0, 0, 1},
+				{1, 0, 0, 0},
+				{0, 1, 0, 0},
+				{0, 0, 1, 0},
+			},
+			expected: []complex128{-1, -1i, 1i, 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vals, err := Eigenvalues(tt.matrix)
+			if err != nil {
+				t.Fatalf("Eigenvalues() error = %v", err)
+			}
+			if len(vals) != len(tt.expected) {
+				t.Fatalf("expected %d eigenvalues, got %d", len(tt.expected), len(vals))
+			}
+			for i := range vals {
+				if cmplx.Abs(vals[i]-tt.expected[i]) > 1e-7 {
+					t.Errorf("Eigenvalues() = %v, expected %v", vals, tt.expected)
+					break
+				}
+			}
+		})
+	}
+}
+
+func TestEigenvalues_Random(t *testing.T) {
+	for _, n := range []int{5, 20, 60} {
+		t.Run(fmt.Sprintf("%dx%d", n, n), func(t *testing.T) {
+			A := randomFloatMatrix(n, n)
+
+			vals, err := Eigenvalues(A)
+			if err != nil {
+				t.Fatalf("Eigenvalues() error = %v", err)
+			}
+
+			// The eigenvalues sum to the trace and complex ones come in conjugate pairs
+			sum := 0i
+			for _, v := range vals {
+				sum += v
+			}
+			trace, _ := Trace(A)
+			if cmplx.Abs(sum-complex(trace, 0)) > 1e-10*float64(n) {
+				t.Errorf("sum of eigenvalues %v != trace %v", sum, trace)
+			}
+			for i, v := range vals {
+				if imag(v) == 0 {
+					continue
+				}
+				found := false
+				for j, w := range vals {
+					if j != i && cmplx.Abs(w-cmplx.Conj(v)) < 1e-10 {
+						found = true
+						break
+					}
+				}
+				if !found {
+					t.Errorf("eigenvalue %v has no conjugate partner", v)
+				}
+			}
+
+			// The Schur form T = Zᵀ·A·Z is quasi upper triangular and reconstructs A
+			h := cloneMatrix(A)
+			z := hessenbergReduce(h, true)
+			if _, err := francisQR(h, z); err != nil {
+				t.Fatalf("francisQR() error = %v", err)
+			}
+			ZT, _ := Multiply(z, h)
+			ZTZt, _ := Multiply(ZT, Transpose(z))
+			if !matricesAlmostEqual(ZTZt, A, 1e-10) {
+				t.Errorf("Z·T·Zᵀ != A")
+			}
+			for i := 2; i < n; i++ {
+				if h[i][i-1] != 0 && h[i-1][i-2] != 0 {
+					t.Fatalf("T has consecutive nonzero subdiagonal entries at row %d", i)
+				}
+			}
+		})
+	}
+}
+
+func TestEigenvalues_MatchesEigenSym(t *testing.T) {
+	R := randomFloatMatrix(30, 30)
+	A, _ := Add(R, Transpose(R))
+
+	vals, err := Eigenvalues(A)
+	if err != nil {
+		t.Fatalf("Eigenvalues() error = %v", err)
+	}
+	symVals, _, _ := EigenSym(A)
+	for i := range vals {
+		if imag(vals[i]) != 0 || math.Abs(real(vals[i])-symVals[i]) > 1e-10 {
+			t.Fatalf("Eigenvalues()[%d] = %v, EigenSym()[%d] = %v", i, vals[i], i, symVals[i])
+		}
+	}
+}
+
+func TestEigenvalues_Errors(t *testing.T) {
+	if _, err := Eigenvalues(Matrix[int]{}); err == nil {
+		t.Error("expected error for empty matrix")
+	}
+	if _, err := Eigenvalues(Matrix[int]{{1, 2, 3}, {4, 5, 6}}); err == nil {
+		t.Error("expected error for non-square matrix")
+	}
+	if _, err := Eigenvalues(Matrix[int]{{1, 2}, {3}}); err == nil {
+		t.Error("expected error for improper matrix")
+	}
+	for _, v := range []float64{math.NaN(), math.Inf(1), math.Inf(-1)} {
+		if _, err := Eigenvalues(Matrix[float64]{{1, 2}, {v, 4}}); !errors.Is(err, ErrInvalidArgument) {
+			t.Errorf("expected ErrInvalidArgument for element %g, got %v", v, err)
+		}
+	}
+}
+
+func BenchmarkEigenvalues(b *testing.B) {
+	sizes := []int{10, 50, 100}
+
+	for _, size := range sizes {
+		b.Run(fmt.Sprintf("%dx%d", size, size), func(b *testing.B) {
+			A := randomFloatMatrix(size, size)
+			b.ResetTimer()
+			for b.Loop() {
+				_, _ = Eigenvalues(A)
+			}
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package matrix
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrEmpty is returned when an operation requires a matrix with at least one
+// element and the input has none.
+var ErrEmpty = errors.New("matrix is empty")
+
+// ErrNotSquare is returned when an operation requires a square matrix.
+var ErrNotSquare = errors.New("matrix is not square")
+
+// ErrDimensionMismatch is matched by DimensionError via errors.Is.
+var ErrDimensionMismatch = errors.New("incompatible dimensions")
+
+// ErrDomain is returned when a matrix function such as Log or Sqrt is not
+// defined, as a real matrix, for the eigenvalues of its argument.
+var ErrDomain = errors.New("matrix has eigenvalues outside the domain of the function")
+
+// ErrOverflow is returned when a result does not fit in its type, such as an
+// exact integer result that exceeds int or a matrix exponential beyond the
+// float64 range.
+var ErrOverflow = errors.New("result overflows int")
+
+// ErrInvalidArgument is returned when a parameter other than the matrix
+// operands, such as an index, a dimension, a tolerance or an iteration limit,
+// is out of range.
+var ErrInvalidArgument = errors.New("invalid argument")
+
+// ErrNoConvergence is returned when an iterative algorithm, such as the QR
+// iteration behind Eigenvalues or SVD, fails to converge within its iteration
+// limit.
+var ErrNoConvergence = errors.New("iteration did not converge")
+
+// ErrSingular is returned when an operation requires a nonsingular (or full
+// rank) matrix and the input is singular.
+var ErrSingular = errors.New("matrix is singular")
+
+// ErrNotSymmetric is returned when an operation requires a symmetric matrix.
+var ErrNotSymmetric = errors.New("matrix is not symmetric")
+
+// ErrNotPositiveDefinite is matched by NotPositiveDefiniteError via errors.Is.
+var ErrNotPositiveDefinite = errors.New("matrix is not positive definite")
+
+// ErrIllConditioned is matched by ConditionError via errors.Is.
+var ErrIllConditioned = errors.New("matrix is ill-conditioned")
+
+// ConditionError reports a linear system whose estimated condition number is
+// too large for its solution to carry any correct digits.
+//
+// Use errors.Is(err, ErrIllConditioned) to test for it, or errors.As to
+// inspect the estimate.
+type ConditionError struct {
+	Cond float64 // Estimated condition number of the coefficient matrix
+}
+
+func (e *ConditionError) Error() string {
+	return fmt.Sprintf("matrix is ill-conditioned: estimated condition number %.3g", e.Cond)
+}
+
+// Unwrap returns ErrIllConditioned so that errors.Is recognizes the error.
+func (e *ConditionError) Unwrap() error {
+	return ErrIllConditioned
+}
+
+// NotPositiveDefiniteError reports the pivot at which a Cholesky
+// factorization broke down because the matrix is not positive definite.
+//
+// Use errors.Is(err, ErrNotPositiveDefinite) to test for it, or errors.As to
+// inspect the failing pivot.
+type NotPositiveDefiniteError struct {
+	Pivot int     // Zero-based index of the failing diagonal pivot
+	Value float64 // Value of the pivot, which is zero, negative or NaN
+}
+
+func (e *NotPositiveDefiniteError) Error() string {
+	return fmt.Sprintf("matrix is not positive definite: pivot %d is %g", e.Pivot, e.Value)
+}
+
+// Unwrap returns ErrNotPositiveDefinite so that errors.Is recognizes the error.
+func (e *NotPositiveDefiniteError) Unwrap() error {
+	return ErrNotPositiveDefinite
+}
+
+// DimensionError reports operands whose shapes are incompatible for an
+// operation, such as multiplying a 2×3 matrix by another 2×3 matrix.
+//
+// Use errors.Is(err, ErrDimensionMismatch) to test for it, or errors.As to
+// inspect the shapes. A vector operand is reported as a column, n×1.
+type DimensionError struct {
+	Op     string   // Operation that rejected the operands, such as "Multiply"
+	Shapes [][2]int // Rows and columns of each operand, in argument order
+}
+
+func (e *DimensionError) Error() string {
+	shapes := make([]string, len(e.Shapes))
+	for i, s := range e.Shapes {
+		shapes[i] = fmt.Sprintf("%d×%d", s[0], s[1])
+	}
+	return fmt.Sprintf("incompatible dimensions for %s: %s", e.Op, strings.Join(shapes, ", "))
+}
+
+// Unwrap returns ErrDimensionMismatch so that errors.Is recognizes the error.
+func (e *DimensionError) Unwrap() error {
+	return ErrDimensionMismatch
+}
+
+// dimensionError returns a DimensionError for op with the given shapes.
+func dimensionError(op string, shapes ...[2]int) error {
+	return &DimensionError{Op: op, Shapes: shapes}
+}
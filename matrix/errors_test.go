@@ -0,0 +1,101 @@
+package matrix
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/rickykimani/linalg/vectors"
+)
+
+func TestErrors_Sentinels(t *testing.T) {
+	square := Matrix[int]{{1, 2}, {3, 4}}
+	wide := Matrix[int]{{1, 2, 3}, {4, 5, 6}}
+	singular := Matrix[int]{{1, 2}, {2, 4}}
+
+	tests := []struct {
+		name   string
+		call   func() error
+		target error
+	}{
+		{"Det empty", func() error { _, err := Det(Matrix[int]{}); return err }, ErrEmpty},
+		{"Det non-square", func() error { _, err := Det(wide); return err }, ErrNotSquare},
+		{"Trace non-square", func() error { _, err := Trace(wide); return err }, ErrNotSquare},
+		{"Inverse non-square", func() error { _, err := Inverse(wide); return err }, ErrNotSquare},
+		{"Inverse singular", func() error { _, err := Inverse(singular); return err }, ErrSingular},
+		{"Pow non-square", func() error { _, err := Pow(wide, 2); return err }, ErrNotSquare},
+		{"NewLU empty", func() error { _, err := NewLU(Matrix[int]{}); return err }, ErrEmpty},
+		{"Cholesky non-square", func() error { _, err := NewCholesky(wide); return err }, ErrNotSquare},
+		{"Eigenvalues empty", func() error { _, err := Eigenvalues(Matrix[int]{}); return err }, ErrEmpty},
+		{"Schur non-square", func() error { _, _, err := Schur(wide); return err }, ErrNotSquare},
+		{"Add mismatch", func() error { _, err := Add(square, wide); return err }, ErrDimensionMismatch},
+		{"Subtract mismatch", func() error { _, err := Subtract(wide, square); return err }, ErrDimensionMismatch},
+		{"Multiply mismatch", func() error { _, err := Multiply(wide, wide); return err }, ErrDimensionMismatch},
+		{"Multiply empty", func() error { _, err := Multiply(Matrix[int]{}, square); return err }, ErrEmpty},
+		{"MultiplyVector mismatch", func() error {
+			_, err := MultiplyVector(wide, vectors.Vector[int]{1, 2})
+			return err
+		}, ErrDimensionMismatch},
+		{"Solve mismatch", func() error {
+			_, err := Solve(square, vectors.Vector[int]{1, 2, 3})
+			return err
+		}, ErrDimensionMismatch},
+		{"Solve singular", func() error {
+			_, err := Solve(singular, vectors.Vector[int]{1, 2})
+			return err
+		}, ErrSingular},
+		{"Solve empty vector", func() error {
+			_, err := Solve(square, vectors.Vector[int]{})
+			return err
+		}, vectors.ErrEmpty},
+		{"LU.SolveVector mismatch", func() error {
+			f, _ := NewLU(square)
+			_, err := f.SolveVector(vectors.Vector[float64]{1})
+			return err
+		}, ErrDimensionMismatch},
+		{"OptimalOmega zero diagonal", func() error { _, err := OptimalOmega(Matrix[int]{{0, 1}, {1, 1}}); return err }, ErrSingular},
+		{"OptimalOmega divergent", func() error { _, err := OptimalOmega(Matrix[int]{{1, 2}, {2, 1}}); return err }, ErrNoConvergence},
+		{"PowerIteration zero maxIter", func() error { _, err := PowerIteration(square, 0, 1e-10); return err }, ErrInvalidArgument},
+		{"NewEmptyMatrix negative", func() error { _, err := NewEmptyMatrix(-1, 2); return err }, ErrInvalidArgument},
+		{"Get out of bounds", func() error { _, err := square.Get(2, 0); return err }, ErrInvalidArgument},
+		{"Slice out of bounds", func() error { _, err := square.Slice(0, 3, 0, 1); return err }, ErrInvalidArgument},
+		{"NewDense negative", func() error { _, err := NewDense(-1, 2, nil); return err }, ErrInvalidArgument},
+		{"NewDense data length", func() error { _, err := NewDense(2, 2, []float64{1}); return err }, ErrDimensionMismatch},
+		{"InverseIteration NaN shift", func() error { _, err := InverseIteration(square, math.NaN(), 10, 1e-10); return err }, ErrInvalidArgument},
+		{"HouseholderQR unknown mode", func() error { _, _, err := HouseholderQR(square, QRMode(-1)); return err }, ErrInvalidArgument},
+		{"SVD unknown mode", func() error { _, _, _, err := SVD(square, SVDMode(-1)); return err }, ErrInvalidArgument},
+		{"Norm unknown type", func() error { _, err := Norm(square, NormType(-1)); return err }, ErrInvalidArgument},
+		{"Dense.Get out of bounds", func() error {
+			d, _ := NewDense(2, 2, nil)
+			_, err := d.Get(0, 2)
+			return err
+		}, ErrInvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.call(); !errors.Is(err, tt.target) {
+				t.Errorf("expected %v, got %v", tt.target, err)
+			}
+		})
+	}
+}
+
+func TestDimensionError(t *testing.T) {
+	_, err := Multiply(Matrix[int]{{1, 2, 3}, {4, 5, 6}}, Matrix[int]{{1, 2}, {3, 4}})
+
+	var dimErr *DimensionError
+	if !errors.As(err, &dimErr) {
+		t.Fatalf("expected *DimensionError, got %T: %v", err, err)
+	}
+	if dimErr.Op != "Multiply" {
+		t.Errorf("expected Op %q, got %q", "Multiply", dimErr.Op)
+	}
+	want := [][2]int{{2, 3}, {2, 2}}
+	if len(dimErr.Shapes) != len(want) || dimErr.Shapes[0] != want[0] || dimErr.Shapes[1] != want[1] {
+		t.Errorf("expected shapes %v, got %v", want, dimErr.Shapes)
+	}
+	if got, msg := err.Error(), "incompatible dimensions for Multiply: 2×3, 2×2"; got != msg {
+		t.Errorf("expected message %q, got %q", msg, got)
+	}
+}
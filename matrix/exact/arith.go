@@ -0,0 +1,133 @@
+package exact
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/rickykimani/linalg/matrix"
+)
+
+// Add returns the elementwise sum a + b.
+//
+// Parameters:
+//   - a: First matrix
+//   - b: Second matrix, with the same dimensions as a
+//
+// Returns:
+//   - Matrix: A new matrix where each element is a[i][j] + b[i][j]
+//   - error: An error if a matrix is invalid, empty (matrix.ErrEmpty) or the
+//     dimensions differ (*matrix.DimensionError)
+func Add(a, b Matrix) (Matrix, error) {
+	return elementwise("Add", a, b, (*big.Rat).Add)
+}
+
+// Subtract returns the elementwise difference a - b.
+//
+// Parameters:
+//   - a: First matrix
+//   - b: Second matrix, with the same dimensions as a
+//
+// Returns:
+//   - Matrix: A new matrix where each element is a[i][j] - b[i][j]
+//   - error: An error if a matrix is invalid, empty (matrix.ErrEmpty) or the
+//     dimensions differ (*matrix.DimensionError)
+func Subtract(a, b Matrix) (Matrix, error) {
+	return elementwise("Subtract", a, b, (*big.Rat).Sub)
+}
+
+// Multiply returns the matrix product a·b.
+//
+// Parameters:
+//   - a: Left matrix of size m×n
+//   - b: Right matrix of size n×p
+//
+// Returns:
+//   - Matrix: The m×p product
+//   - error: An error if a matrix is invalid, empty (matrix.ErrEmpty) or the
+//     number of columns of a differs from the number of rows of b
+//     (*matrix.DimensionError)
+//
+// Time complexity: O(m×n×p) rational operations.
+func Multiply(a, b Matrix) (Matrix, error) {
+	if err := check(a); err != nil {
+		return nil, fmt.Errorf("first matrix: %w", err)
+	}
+	if err := check(b); err != nil {
+		return nil, fmt.Errorf("second matrix: %w", err)
+	}
+	if a.Cols() != b.Rows() {
+		return nil, &matrix.DimensionError{Op: "Multiply", Shapes: [][2]int{a.shape(), b.shape()}}
+	}
+
+	result, _ := New(a.Rows(), b.Cols())
+	tmp := new(big.Rat)
+	for i := range a {
+		for k, aik := range a[i] {
+			if aik.Sign() == 0 {
+				continue
+			}
+			for j := range b[k] {
+				result[i][j].Add(result[i][j], tmp.Mul(aik, b[k][j]))
+			}
+		}
+	}
+	return result, nil
+}
+
+// Scale returns the matrix with every element multiplied by s.
+//
+// An invalid matrix yields an empty matrix, as with matrix.Scale.
+func Scale(s *big.Rat, m Matrix) Matrix {
+	if m.Validate() != nil {
+		return Matrix{}
+	}
+
+	result := make(Matrix, len(m))
+	for i, row := range m {
+		result[i] = make([]*big.Rat, len(row))
+		for j, v := range row {
+			result[i][j] = new(big.Rat).Mul(s, v)
+		}
+	}
+	return result
+}
+
+// Transpose returns the transpose of the matrix, with [Aᵀ][i][j] = A[j][i].
+//
+// An invalid or empty matrix yields an empty matrix, as with matrix.Transpose.
+func Transpose(m Matrix) Matrix {
+	if m.Validate() != nil || len(m) == 0 {
+		return Matrix{}
+	}
+
+	result := make(Matrix, m.Cols())
+	for j := range result {
+		result[j] = make([]*big.Rat, len(m))
+		for i := range m {
+			result[j][i] = new(big.Rat).Set(m[i][j])
+		}
+	}
+	return result
+}
+
+// elementwise applies op to corresponding elements of a and b.
+func elementwise(name string, a, b Matrix, op func(z, x, y *big.Rat) *big.Rat) (Matrix, error) {
+	if err := check(a); err != nil {
+		return nil, fmt.Errorf("first matrix: %w", err)
+	}
+	if err := check(b); err != nil {
+		return nil, fmt.Errorf("second matrix: %w", err)
+	}
+	if a.Rows() != b.Rows() || a.Cols() != b.Cols() {
+		return nil, &matrix.DimensionError{Op: name, Shapes: [][2]int{a.shape(), b.shape()}}
+	}
+
+	result := make(Matrix, len(a))
+	for i := range a {
+		result[i] = make([]*big.Rat, len(a[i]))
+		for j := range a[i] {
+			result[i][j] = op(new(big.Rat), a[i][j], b[i][j])
+		}
+	}
+	return result, nil
+}
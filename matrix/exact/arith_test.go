@@ -0,0 +1,116 @@
+package exact
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/rickykimani/linalg/matrix"
+)
+
+func TestAddSubtract(t *testing.T) {
+	a := parse(t, [][]string{{"1/2", "1/3"}, {"1", "-1/4"}})
+	b := parse(t, [][]string{{"1/2", "1/6"}, {"-1", "1/4"}})
+
+	sum, err := Add(a, b)
+	if err != nil {
+		t.Fatalf("Add() error = %v", err)
+	}
+	if want := parse(t, [][]string{{"1", "1/2"}, {"0", "0"}}); !Equal(sum, want) {
+		t.Errorf("Add() = %v, expected %v", sum, want)
+	}
+
+	diff, err := Subtract(a, b)
+	if err != nil {
+		t.Fatalf("Subtract() error = %v", err)
+	}
+	if want := parse(t, [][]string{{"0", "1/6"}, {"2", "-1/2"}}); !Equal(diff, want) {
+		t.Errorf("Subtract() = %v, expected %v", diff, want)
+	}
+
+	// The inputs are left untouched
+	if a[0][0].Cmp(big.NewRat(1, 2)) != 0 {
+		t.Error("Add() modified its argument")
+	}
+
+	var de *matrix.DimensionError
+	if _, err := Add(a, parse(t, [][]string{{"1", "2", "3"}})); !errors.As(err, &de) || de.Op != "Add" {
+		t.Errorf("expected *matrix.DimensionError for Add, got %v", err)
+	}
+	if _, err := Subtract(Matrix{}, b); !errors.Is(err, matrix.ErrEmpty) {
+		t.Errorf("expected matrix.ErrEmpty, got %v", err)
+	}
+}
+
+func TestMultiply(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b [][]string
+		want [][]string
+	}{
+		{
+			name: "2x2",
+			a:    [][]string{{"1/2", "1"}, {"0", "2/3"}},
+			b:    [][]string{{"2", "0"}, {"3", "3/2"}},
+			want: [][]string{{"4", "3/2"}, {"2", "1"}},
+		},
+		{
+			name: "Row times column",
+			a:    [][]string{{"1/2", "1/3", "1/6"}},
+			b:    [][]string{{"1"}, {"1"}, {"1"}},
+			want: [][]string{{"1"}},
+		},
+		{
+			name: "Column times row",
+			a:    [][]string{{"1"}, {"-1/2"}},
+			b:    [][]string{{"2", "4"}},
+			want: [][]string{{"2", "4"}, {"-1", "-2"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Multiply(parse(t, tt.a), parse(t, tt.b))
+			if err != nil {
+				t.Fatalf("Multiply() error = %v", err)
+			}
+			if want := parse(t, tt.want); !Equal(got, want) {
+				t.Errorf("Multiply() = %v, expected %v", got, want)
+			}
+		})
+	}
+
+	a := parse(t, [][]string{{"1", "2"}})
+	if _, err := Multiply(a, a); !errors.Is(err, matrix.ErrDimensionMismatch) {
+		t.Errorf("expected matrix.ErrDimensionMismatch, got %v", err)
+	}
+	if _, err := Multiply(a, Matrix{}); !errors.Is(err, matrix.ErrEmpty) {
+		t.Errorf("expected matrix.ErrEmpty, got %v", err)
+	}
+}
+
+func TestScale(t *testing.T) {
+	m := parse(t, [][]string{{"3", "-1/2"}})
+	got := Scale(big.NewRat(2, 3), m)
+	if want := parse(t, [][]string{{"2", "-1/3"}}); !Equal(got, want) {
+		t.Errorf("Scale() = %v, expected %v", got, want)
+	}
+	if got := Scale(big.NewRat(1, 1), Matrix{{nil}}); len(got) != 0 {
+		t.Errorf("Scale() of an invalid matrix = %v, expected an empty matrix", got)
+	}
+}
+
+func TestTranspose(t *testing.T) {
+	m := parse(t, [][]string{{"1", "2/3", "3"}, {"4", "5", "-6"}})
+	got := Transpose(m)
+	if want := parse(t, [][]string{{"1", "4"}, {"2/3", "5"}, {"3", "-6"}}); !Equal(got, want) {
+		t.Errorf("Transpose() = %v, expected %v", got, want)
+	}
+	got[0][0].SetInt64(9)
+	if m[0][0].Cmp(big.NewRat(1, 1)) != 0 {
+		t.Error("Transpose() shares elements with its argument")
+	}
+	if got := Transpose(Matrix{}); len(got) != 0 {
+		t.Errorf("Transpose() of an empty matrix = %v", got)
+	}
+}
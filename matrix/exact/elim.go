@@ -0,0 +1,241 @@
+package exact
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/rickykimani/linalg/matrix"
+)
+
+// RREF computes the reduced row echelon form of a matrix by Gauss–Jordan
+// elimination.
+//
+// Parameters:
+//   - m: Input matrix
+//
+// Returns:
+//   - Matrix: The reduced row echelon form, in which every pivot is 1 and is the
+//     only nonzero element of its column
+//   - []int: The pivot columns in increasing order; their count is the rank
+//   - error: An error if the matrix is invalid or empty (matrix.ErrEmpty)
+//
+// The reduced row echelon form is unique, so the result does not depend on the
+// order of the row operations. In exact arithmetic any nonzero element is a
+// valid pivot and the first one found in each column is used.
+//
+// Example:
+//
+//	m, _ := FromStrings([][]string{{"1", "2", "1"}, {"2", "4", "0"}})
+//	r, pivots, _ := RREF(m)  // r = [[1, 2, 0], [0, 0, 1]], pivots = [0, 2]
+//
+// Time complexity: O(m×n×min(m, n)) rational operations.
+func RREF(m Matrix) (Matrix, []int, error) {
+	if err := check(m); err != nil {
+		return nil, nil, err
+	}
+
+	r := m.Clone()
+	pivots := reduce(r, r.Cols())
+	return r, pivots, nil
+}
+
+// Rank returns the rank of a matrix, the number of pivots in its reduced row
+// echelon form.
+//
+// Unlike matrix.Rank, no tolerance is involved: a matrix is rank deficient
+// exactly when its rows are linearly dependent over the rationals.
+//
+// Returns an error if the matrix is invalid or empty (matrix.ErrEmpty).
+func Rank(m Matrix) (int, error) {
+	if err := check(m); err != nil {
+		return 0, err
+	}
+
+	return len(reduce(m.Clone(), m.Cols())), nil
+}
+
+// Det returns the exact determinant of a square matrix.
+//
+// Parameters:
+//   - m: Square input matrix
+//
+// Returns:
+//   - *big.Rat: The determinant, which is zero exactly when the matrix is singular
+//   - error: An error if the matrix is invalid, empty (matrix.ErrEmpty) or not
+//     square (matrix.ErrNotSquare)
+//
+// Time complexity: O(n³) rational operations where n is the matrix dimension.
+func Det(m Matrix) (*big.Rat, error) {
+	if err := checkSquare(m); err != nil {
+		return nil, err
+	}
+
+	// Forward elimination; the determinant is the signed product of the pivots
+	a := m.Clone()
+	n := len(a)
+	det := big.NewRat(1, 1)
+	f, tmp := new(big.Rat), new(big.Rat)
+	for k := range n {
+		p := k
+		for p < n && a[p][k].Sign() == 0 {
+			p++
+		}
+		if p == n {
+			return new(big.Rat), nil
+		}
+		if p != k {
+			a[k], a[p] = a[p], a[k]
+			det.Neg(det)
+		}
+		det.Mul(det, a[k][k])
+
+		for i := k + 1; i < n; i++ {
+			if a[i][k].Sign() == 0 {
+				continue
+			}
+			f.Quo(a[i][k], a[k][k])
+			for j := k + 1; j < n; j++ {
+				a[i][j].Sub(a[i][j], tmp.Mul(f, a[k][j]))
+			}
+		}
+	}
+	return det, nil
+}
+
+// Inverse returns the exact inverse of a square matrix.
+//
+// Parameters:
+//   - m: Square input matrix
+//
+// Returns:
+//   - Matrix: The matrix A⁻¹ with A·A⁻¹ = A⁻¹·A = I exactly
+//   - error: An error if the matrix is invalid, empty (matrix.ErrEmpty), not
+//     square (matrix.ErrNotSquare) or singular (matrix.ErrSingular)
+//
+// The inverse is computed by Gauss–Jordan elimination on the augmented
+// matrix [A | I].
+//
+// Time complexity: O(n³) rational operations where n is the matrix dimension.
+func Inverse(m Matrix) (Matrix, error) {
+	if err := checkSquare(m); err != nil {
+		return nil, err
+	}
+
+	n := len(m)
+	aug := make(Matrix, n)
+	for i := range n {
+		aug[i] = make([]*big.Rat, 2*n)
+		for j := range n {
+			aug[i][j] = new(big.Rat).Set(m[i][j])
+			aug[i][n+j] = new(big.Rat)
+		}
+		aug[i][n+i].SetInt64(1)
+	}
+
+	if pivots := reduce(aug, n); len(pivots) < n {
+		return nil, matrix.ErrSingular
+	}
+
+	result := make(Matrix, n)
+	for i := range n {
+		result[i] = aug[i][n:]
+	}
+	return result, nil
+}
+
+// Solve solves the square linear system A·x = b exactly.
+//
+// Parameters:
+//   - a: Square coefficient matrix
+//   - b: Right-hand side with one element per row of a
+//
+// Returns:
+//   - []*big.Rat: The unique solution x
+//   - error: An error if the matrix is invalid, empty (matrix.ErrEmpty) or not
+//     square (matrix.ErrNotSquare), b has a nil element or the wrong length
+//     (*matrix.DimensionError), or the matrix is singular (matrix.ErrSingular)
+//
+// Use RREF on the augmented matrix [A | b] to describe the solutions of a
+// singular or rectangular system.
+//
+// Example:
+//
+//	a, _ := FromStrings([][]string{{"2", "1"}, {"1", "3"}})
+//	x, _ := Solve(a, []*big.Rat{big.NewRat(3, 1), big.NewRat(5, 1)})  // x = [4/5, 7/5]
+//
+// Time complexity: O(n³) rational operations where n is the matrix dimension.
+func Solve(a Matrix, b []*big.Rat) ([]*big.Rat, error) {
+	if err := checkSquare(a); err != nil {
+		return nil, err
+	}
+	n := len(a)
+	if len(b) != n {
+		return nil, &matrix.DimensionError{Op: "Solve", Shapes: [][2]int{a.shape(), {len(b), 1}}}
+	}
+
+	aug := make(Matrix, n)
+	for i := range n {
+		if b[i] == nil {
+			return nil, fmt.Errorf("element %d of the right-hand side is nil", i)
+		}
+		aug[i] = make([]*big.Rat, n+1)
+		for j := range n {
+			aug[i][j] = new(big.Rat).Set(a[i][j])
+		}
+		aug[i][n] = new(big.Rat).Set(b[i])
+	}
+
+	if pivots := reduce(aug, n); len(pivots) < n {
+		return nil, matrix.ErrSingular
+	}
+
+	x := make([]*big.Rat, n)
+	for i := range n {
+		x[i] = aug[i][n]
+	}
+	return x, nil
+}
+
+// reduce brings a to reduced row echelon form in place by Gauss–Jordan
+// elimination, choosing pivots only among the first ncols columns; the
+// remaining columns, such as an augmented right-hand side, are carried along.
+// It returns the pivot columns.
+func reduce(a Matrix, ncols int) []int {
+	rows := len(a)
+	f, tmp := new(big.Rat), new(big.Rat)
+	var pivots []int
+	r := 0
+	for c := 0; c < ncols && r < rows; c++ {
+		p := r
+		for p < rows && a[p][c].Sign() == 0 {
+			p++
+		}
+		if p == rows {
+			continue
+		}
+		if p != r {
+			a[r], a[p] = a[p], a[r]
+		}
+
+		// Scale the pivot row so that the pivot is 1
+		f.Inv(a[r][c])
+		for j := c; j < len(a[r]); j++ {
+			a[r][j].Mul(a[r][j], f)
+		}
+
+		// Clear the pivot column above and below the pivot
+		for i := range rows {
+			if i == r || a[i][c].Sign() == 0 {
+				continue
+			}
+			f.Set(a[i][c])
+			for j := c; j < len(a[i]); j++ {
+				a[i][j].Sub(a[i][j], tmp.Mul(f, a[r][j]))
+			}
+		}
+
+		pivots = append(pivots, c)
+		r++
+	}
+	return pivots
+}
@@ -0,0 +1,218 @@
+package exact
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"testing"
+
+	"github.com/rickykimani/linalg/matrix"
+)
+
+func TestRREF(t *testing.T) {
+	tests := []struct {
+		name   string
+		matrix [][]string
+		want   [][]string
+		pivots []int
+	}{
+		{
+			name:   "Full rank",
+			matrix: [][]string{{"2", "1"}, {"1", "3"}},
+			want:   [][]string{{"1", "0"}, {"0", "1"}},
+			pivots: []int{0, 1},
+		},
+		{
+			name:   "Skipped column",
+			matrix: [][]string{{"1", "2", "1"}, {"2", "4", "0"}},
+			want:   [][]string{{"1", "2", "0"}, {"0", "0", "1"}},
+			pivots: []int{0, 2},
+		},
+		{
+			name:   "Zero pivot needs a swap",
+			matrix: [][]string{{"0", "3", "6"}, {"2", "4", "2"}, {"1", "2", "1"}},
+			want:   [][]string{{"1", "0", "-3"}, {"0", "1", "2"}, {"0", "0", "0"}},
+			pivots: []int{0, 1},
+		},
+		{
+			name:   "Fractions",
+			matrix: [][]string{{"1/2", "1/3", "1"}, {"1/4", "1/5", "0"}},
+			want:   [][]string{{"1", "0", "12"}, {"0", "1", "-15"}},
+			pivots: []int{0, 1},
+		},
+		{
+			name:   "Zero matrix",
+			matrix: [][]string{{"0", "0"}, {"0", "0"}},
+			want:   [][]string{{"0", "0"}, {"0", "0"}},
+			pivots: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := parse(t, tt.matrix)
+			got, pivots, err := RREF(m)
+			if err != nil {
+				t.Fatalf("RREF() error = %v", err)
+			}
+			if want := parse(t, tt.want); !Equal(got, want) {
+				t.Errorf("RREF() = %v, expected %v", got, want)
+			}
+			if !slices.Equal(pivots, tt.pivots) {
+				t.Errorf("pivots = %v, expected %v", pivots, tt.pivots)
+			}
+			if !Equal(m, parse(t, tt.matrix)) {
+				t.Error("RREF() modified its argument")
+			}
+
+			rank, _ := Rank(m)
+			if rank != len(tt.pivots) {
+				t.Errorf("Rank() = %d, expected %d", rank, len(tt.pivots))
+			}
+		})
+	}
+
+	if _, _, err := RREF(Matrix{}); !errors.Is(err, matrix.ErrEmpty) {
+		t.Errorf("expected matrix.ErrEmpty, got %v", err)
+	}
+	if _, err := Rank(Matrix{{nil}}); err == nil {
+		t.Error("expected error for a nil element")
+	}
+}
+
+func TestDet(t *testing.T) {
+	tests := []struct {
+		name   string
+		matrix Matrix
+		want   *big.Rat
+	}{
+		{name: "1x1", matrix: parse(t, [][]string{{"-2/3"}}), want: big.NewRat(-2, 3)},
+		{name: "2x2", matrix: parse(t, [][]string{{"3", "8"}, {"4", "6"}}), want: big.NewRat(-14, 1)},
+		{name: "Swap", matrix: parse(t, [][]string{{"0", "1"}, {"1", "0"}}), want: big.NewRat(-1, 1)},
+		{name: "Singular", matrix: parse(t, [][]string{{"1/2", "1"}, {"1", "2"}}), want: new(big.Rat)},
+		// det of the 5×5 Hilbert matrix is 1/266716800000
+		{name: "Hilbert 5x5", matrix: hilbert(5), want: big.NewRat(1, 266716800000)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Det(tt.matrix)
+			if err != nil {
+				t.Fatalf("Det() error = %v", err)
+			}
+			if got.Cmp(tt.want) != 0 {
+				t.Errorf("Det() = %v, expected %v", got.RatString(), tt.want.RatString())
+			}
+		})
+	}
+
+	if _, err := Det(parse(t, [][]string{{"1", "2"}})); !errors.Is(err, matrix.ErrNotSquare) {
+		t.Errorf("expected matrix.ErrNotSquare, got %v", err)
+	}
+}
+
+func TestInverse(t *testing.T) {
+	// The inverse of a Hilbert matrix has integer elements
+	h := hilbert(4)
+	inv, err := Inverse(h)
+	if err != nil {
+		t.Fatalf("Inverse() error = %v", err)
+	}
+	want := parse(t, [][]string{
+		{"16", "-120", "240", "-140"},
+		{"-120", "1200", "-2700", "1680"},
+		{"240", "-2700", "6480", "-4200"},
+		{"-140", "1680", "-4200", "2800"},
+	})
+	if !Equal(inv, want) {
+		t.Errorf("Inverse() = %v, expected %v", inv, want)
+	}
+
+	for _, n := range []int{3, 8} {
+		t.Run(fmt.Sprintf("Hilbert %dx%d", n, n), func(t *testing.T) {
+			h := hilbert(n)
+			inv, err := Inverse(h)
+			if err != nil {
+				t.Fatalf("Inverse() error = %v", err)
+			}
+			product, _ := Multiply(h, inv)
+			if !Equal(product, Identity(n)) {
+				t.Errorf("A·A⁻¹ = %v, expected the identity", product)
+			}
+		})
+	}
+
+	singular := parse(t, [][]string{{"1", "2", "3"}, {"4", "5", "6"}, {"7", "8", "9"}})
+	if _, err := Inverse(singular); !errors.Is(err, matrix.ErrSingular) {
+		t.Errorf("expected matrix.ErrSingular, got %v", err)
+	}
+	if _, err := Inverse(Matrix{}); !errors.Is(err, matrix.ErrEmpty) {
+		t.Errorf("expected matrix.ErrEmpty, got %v", err)
+	}
+}
+
+func TestSolve(t *testing.T) {
+	a := parse(t, [][]string{{"2", "1"}, {"1", "3"}})
+	x, err := Solve(a, []*big.Rat{big.NewRat(3, 1), big.NewRat(5, 1)})
+	if err != nil {
+		t.Fatalf("Solve() error = %v", err)
+	}
+	if x[0].Cmp(big.NewRat(4, 5)) != 0 || x[1].Cmp(big.NewRat(7, 5)) != 0 {
+		t.Errorf("Solve() = [%v, %v], expected [4/5, 7/5]", x[0].RatString(), x[1].RatString())
+	}
+
+	// H·x = H·1 recovers x = 1 exactly, where floating point loses most digits
+	h := hilbert(10)
+	b := make([]*big.Rat, 10)
+	for i := range h {
+		b[i] = new(big.Rat)
+		for _, v := range h[i] {
+			b[i].Add(b[i], v)
+		}
+	}
+	x, err = Solve(h, b)
+	if err != nil {
+		t.Fatalf("Solve() error = %v", err)
+	}
+	for i, v := range x {
+		if v.Cmp(big.NewRat(1, 1)) != 0 {
+			t.Errorf("x[%d] = %v, expected 1", i, v.RatString())
+		}
+	}
+
+	tests := []struct {
+		name   string
+		a      Matrix
+		b      []*big.Rat
+		target error
+	}{
+		{"Singular", parse(t, [][]string{{"1", "2"}, {"2", "4"}}), []*big.Rat{big.NewRat(1, 1), big.NewRat(2, 1)}, matrix.ErrSingular},
+		{"Wrong length", a, []*big.Rat{big.NewRat(1, 1)}, matrix.ErrDimensionMismatch},
+		{"Non-square", parse(t, [][]string{{"1", "2"}}), []*big.Rat{big.NewRat(1, 1)}, matrix.ErrNotSquare},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Solve(tt.a, tt.b); !errors.Is(err, tt.target) {
+				t.Errorf("expected %v, got %v", tt.target, err)
+			}
+		})
+	}
+	if _, err := Solve(a, []*big.Rat{big.NewRat(1, 1), nil}); err == nil {
+		t.Error("expected error for a nil element")
+	}
+}
+
+func BenchmarkInverse(b *testing.B) {
+	sizes := []int{5, 10, 20}
+
+	for _, size := range sizes {
+		b.Run(fmt.Sprintf("%dx%d", size, size), func(b *testing.B) {
+			h := hilbert(size)
+			b.ResetTimer()
+			for b.Loop() {
+				_, _ = Inverse(h)
+			}
+		})
+	}
+}
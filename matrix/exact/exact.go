@@ -0,0 +1,259 @@
+// Package exact provides matrices of arbitrary-precision rationals, for
+// computations that must be free of rounding error.
+//
+// Every element of a Matrix is a *big.Rat, and every operation, from Add to
+// Inverse and RREF, is carried out in exact rational arithmetic. The results
+// are the ones a textbook would give: the inverse of a Hilbert matrix has
+// integer entries rather than approximations with a few correct digits, and
+// a singular matrix is recognized as singular without any tolerance.
+//
+// Exactness has a cost. Numerators and denominators grow during elimination,
+// so these methods are much slower than their counterparts in the matrix
+// package and are meant for small matrices, teaching and verification.
+//
+// Matrices convert from matrix.Matrix[int] and matrix.Matrix[float64] with
+// FromMatrix, from strings such as "1/3" or "0.25" with FromStrings, and back
+// to floating point with ToMatrix. Errors reuse the sentinels of the matrix
+// package, so errors.Is(err, matrix.ErrSingular) works for both.
+package exact
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/rickykimani/linalg/matrix"
+)
+
+// Matrix is a dense matrix of rationals, stored row by row.
+//
+// Functions in this package never modify their arguments and always return
+// matrices with freshly allocated elements, so results can be changed in
+// place without affecting the inputs.
+type Matrix [][]*big.Rat
+
+// New creates a rows×cols matrix with every element set to zero.
+//
+// Parameters:
+//   - rows: The number of rows
+//   - cols: The number of columns
+//
+// Returns:
+//   - Matrix: The zero matrix
+//   - error: An error if a dimension is negative (matrix.ErrInvalidArgument)
+func New(rows, cols int) (Matrix, error) {
+	if rows < 0 || cols < 0 {
+		return nil, fmt.Errorf("matrix dimensions cannot be negative: got %d×%d: %w", rows, cols, matrix.ErrInvalidArgument)
+	}
+
+	m := make(Matrix, rows)
+	for i := range rows {
+		m[i] = make([]*big.Rat, cols)
+		for j := range cols {
+			m[i][j] = new(big.Rat)
+		}
+	}
+	return m, nil
+}
+
+// Identity creates an n×n identity matrix.
+//
+// A non-positive n yields an empty matrix.
+func Identity(n int) Matrix {
+	m, _ := New(max(n, 0), max(n, 0))
+	for i := range m {
+		m[i][i].SetInt64(1)
+	}
+	return m
+}
+
+// FromMatrix converts a matrix of ints or floats to a rational matrix.
+//
+// Parameters:
+//   - m: Input matrix of type matrix.Matrix[T] where T is int or float64
+//
+// Returns:
+//   - Matrix: The rational matrix with the same elements
+//   - error: An error if the rows have inconsistent lengths or an element is
+//     NaN or infinite
+//
+// A float64 is converted to the rational it represents exactly, which is a
+// fraction with a power of two as denominator: 0.5 becomes 1/2, but 0.1
+// becomes 3602879701896397/36028797018963968. Use FromStrings to enter
+// decimal values such as 0.1 as the fraction 1/10.
+func FromMatrix[T int | float64](m matrix.Matrix[T]) (Matrix, error) {
+	if err := m.Validate(); err != nil {
+		return nil, err
+	}
+
+	result := make(Matrix, len(m))
+	for i, row := range m {
+		result[i] = make([]*big.Rat, len(row))
+		for j, v := range row {
+			switch v := any(v).(type) {
+			case int:
+				result[i][j] = new(big.Rat).SetInt64(int64(v))
+			case float64:
+				r := new(big.Rat).SetFloat64(v)
+				if r == nil {
+					return nil, fmt.Errorf("element (%d, %d) is not finite: %g", i, j, v)
+				}
+				result[i][j] = r
+			}
+		}
+	}
+	return result, nil
+}
+
+// FromStrings parses a rational matrix from its elements written as strings.
+//
+// Parameters:
+//   - data: Rows of elements, each an integer ("-3"), a fraction ("2/3") or a
+//     decimal number ("0.1", "1e-3"), as accepted by (*big.Rat).SetString
+//
+// Returns:
+//   - Matrix: The rational matrix, where decimal numbers are converted exactly
+//     (0.1 becomes 1/10)
+//   - error: An error if the rows have inconsistent lengths or an element
+//     cannot be parsed
+//
+// Example:
+//
+//	m, _ := FromStrings([][]string{{"1/2", "1/3"}, {"0.25", "-1"}})
+func FromStrings(data [][]string) (Matrix, error) {
+	result := make(Matrix, len(data))
+	for i, row := range data {
+		if len(row) != len(data[0]) {
+			return nil, fmt.Errorf("inconsistent row length at row %d: expected %d, got %d", i, len(data[0]), len(row))
+		}
+		result[i] = make([]*big.Rat, len(row))
+		for j, s := range row {
+			r, ok := new(big.Rat).SetString(strings.TrimSpace(s))
+			if !ok {
+				return nil, fmt.Errorf("element (%d, %d): cannot parse %q as a rational number", i, j, s)
+			}
+			result[i][j] = r
+		}
+	}
+	return result, nil
+}
+
+// ToMatrix converts the matrix to floating point, rounding each element to
+// the nearest float64.
+func (m Matrix) ToMatrix() matrix.Matrix[float64] {
+	result := make(matrix.Matrix[float64], len(m))
+	for i, row := range m {
+		result[i] = make([]float64, len(row))
+		for j, v := range row {
+			result[i][j], _ = v.Float64()
+		}
+	}
+	return result
+}
+
+// Validate checks that all rows have the same length and that no element is nil.
+func (m Matrix) Validate() error {
+	for i, row := range m {
+		if len(row) != len(m[0]) {
+			return fmt.Errorf("inconsistent row length at row %d: expected %d, got %d", i, len(m[0]), len(row))
+		}
+		for j, v := range row {
+			if v == nil {
+				return fmt.Errorf("element (%d, %d) is nil", i, j)
+			}
+		}
+	}
+	return nil
+}
+
+// Rows returns the number of rows in the matrix.
+func (m Matrix) Rows() int {
+	return len(m)
+}
+
+// Cols returns the number of columns in the matrix, or 0 if it is empty.
+func (m Matrix) Cols() int {
+	if len(m) == 0 {
+		return 0
+	}
+	return len(m[0])
+}
+
+// Clone returns a deep copy of the matrix.
+func (m Matrix) Clone() Matrix {
+	result := make(Matrix, len(m))
+	for i, row := range m {
+		result[i] = make([]*big.Rat, len(row))
+		for j, v := range row {
+			result[i][j] = new(big.Rat).Set(v)
+		}
+	}
+	return result
+}
+
+// Equal reports whether a and b have the same shape and equal elements.
+func Equal(a, b Matrix) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if len(a[i]) != len(b[i]) {
+			return false
+		}
+		for j := range a[i] {
+			if a[i][j].Cmp(b[i][j]) != 0 {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// String formats the matrix with each element in lowest terms, such as
+// [[1/2, -1], [0, 3]].
+func (m Matrix) String() string {
+	var sb strings.Builder
+	sb.WriteByte('[')
+	for i, row := range m {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteByte('[')
+		for j, v := range row {
+			if j > 0 {
+				sb.WriteString(", ")
+			}
+			sb.WriteString(v.RatString())
+		}
+		sb.WriteByte(']')
+	}
+	sb.WriteByte(']')
+	return sb.String()
+}
+
+// shape returns the rows and columns of m, for DimensionError.
+func (m Matrix) shape() [2]int {
+	return [2]int{m.Rows(), m.Cols()}
+}
+
+// check validates m and rejects an empty matrix.
+func check(m Matrix) error {
+	if err := m.Validate(); err != nil {
+		return fmt.Errorf("invalid matrix: %w", err)
+	}
+	if len(m) == 0 || len(m[0]) == 0 {
+		return matrix.ErrEmpty
+	}
+	return nil
+}
+
+// checkSquare validates m and rejects an empty or non-square matrix.
+func checkSquare(m Matrix) error {
+	if err := check(m); err != nil {
+		return err
+	}
+	if len(m) != len(m[0]) {
+		return matrix.ErrNotSquare
+	}
+	return nil
+}
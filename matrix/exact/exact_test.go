@@ -0,0 +1,131 @@
+package exact
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/rickykimani/linalg/matrix"
+)
+
+// parse builds a matrix from strings, failing the test on a parse error.
+func parse(t testing.TB, data [][]string) Matrix {
+	t.Helper()
+	m, err := FromStrings(data)
+	if err != nil {
+		t.Fatalf("FromStrings() error = %v", err)
+	}
+	return m
+}
+
+// hilbert returns the n×n Hilbert matrix with elements 1/(i+j+1).
+func hilbert(n int) Matrix {
+	h, _ := New(n, n)
+	for i := range n {
+		for j := range n {
+			h[i][j].SetFrac64(1, int64(i+j+1))
+		}
+	}
+	return h
+}
+
+func TestFromMatrix(t *testing.T) {
+	m, err := FromMatrix(matrix.Matrix[int]{{1, -2}, {3, 4}})
+	if err != nil {
+		t.Fatalf("FromMatrix() error = %v", err)
+	}
+	if want := parse(t, [][]string{{"1", "-2"}, {"3", "4"}}); !Equal(m, want) {
+		t.Errorf("FromMatrix() = %v, expected %v", m, want)
+	}
+
+	// Floats convert to the binary fraction they represent
+	f, err := FromMatrix(matrix.Matrix[float64]{{0.5, 0.1}})
+	if err != nil {
+		t.Fatalf("FromMatrix() error = %v", err)
+	}
+	if f[0][0].Cmp(big.NewRat(1, 2)) != 0 {
+		t.Errorf("0.5 converted to %v, expected 1/2", f[0][0])
+	}
+	if f[0][1].Cmp(big.NewRat(1, 10)) == 0 || f[0][1].Denom().BitLen() != 56 {
+		t.Errorf("0.1 converted to %v, expected the exact float64 value", f[0][1])
+	}
+
+	if _, err := FromMatrix(matrix.Matrix[float64]{{math.Inf(1)}}); err == nil {
+		t.Error("expected error for an infinite element")
+	}
+	if _, err := FromMatrix(matrix.Matrix[int]{{1, 2}, {3}}); err == nil {
+		t.Error("expected error for improper matrix")
+	}
+}
+
+func TestFromStrings(t *testing.T) {
+	m := parse(t, [][]string{{"1/3", " 0.1 "}, {"-4/6", "1e-2"}})
+	want := Matrix{
+		{big.NewRat(1, 3), big.NewRat(1, 10)},
+		{big.NewRat(-2, 3), big.NewRat(1, 100)},
+	}
+	if !Equal(m, want) {
+		t.Errorf("FromStrings() = %v, expected %v", m, want)
+	}
+
+	if _, err := FromStrings([][]string{{"1", "x"}}); err == nil {
+		t.Error("expected error for an unparsable element")
+	}
+	if _, err := FromStrings([][]string{{"1", "2"}, {"3"}}); err == nil {
+		t.Error("expected error for improper matrix")
+	}
+}
+
+func TestToMatrix(t *testing.T) {
+	got := parse(t, [][]string{{"1/4", "-3"}, {"1/3", "0"}}).ToMatrix()
+	want := matrix.Matrix[float64]{{0.25, -3}, {1.0 / 3, 0}}
+	for i := range want {
+		for j := range want[i] {
+			if got[i][j] != want[i][j] {
+				t.Errorf("ToMatrix()[%d][%d] = %v, expected %v", i, j, got[i][j], want[i][j])
+			}
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	if err := (Matrix{{big.NewRat(1, 1), nil}}).Validate(); err == nil {
+		t.Error("expected error for a nil element")
+	}
+	if err := (Matrix{{new(big.Rat)}, {}}).Validate(); err == nil {
+		t.Error("expected error for inconsistent rows")
+	}
+	if err := (Matrix{}).Validate(); err != nil {
+		t.Errorf("empty matrix should be valid, got %v", err)
+	}
+}
+
+func TestClone(t *testing.T) {
+	m := parse(t, [][]string{{"1", "2"}})
+	c := m.Clone()
+	c[0][0].SetInt64(5)
+	if m[0][0].Cmp(big.NewRat(1, 1)) != 0 {
+		t.Error("modifying the clone changed the original")
+	}
+}
+
+func TestString(t *testing.T) {
+	m := parse(t, [][]string{{"2/4", "-1"}, {"0", "3"}})
+	if got, want := m.String(), "[[1/2, -1], [0, 3]]"; got != want {
+		t.Errorf("String() = %q, expected %q", got, want)
+	}
+}
+
+func TestIdentity(t *testing.T) {
+	want := parse(t, [][]string{{"1", "0", "0"}, {"0", "1", "0"}, {"0", "0", "1"}})
+	if got := Identity(3); !Equal(got, want) {
+		t.Errorf("Identity(3) = %v", got)
+	}
+	if got := Identity(-1); len(got) != 0 {
+		t.Errorf("Identity(-1) = %v, expected an empty matrix", got)
+	}
+	if _, err := New(-1, 2); !errors.Is(err, matrix.ErrInvalidArgument) {
+		t.Errorf("expected ErrInvalidArgument for a negative dimension, got %v", err)
+	}
+}
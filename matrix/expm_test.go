@@ -0,0 +1,150 @@
+package matrix
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestExp(t *testing.T) {
+	e := math.E
+	tests := []struct {
+		name     string
+		matrix   Matrix[float64]
+		expected Matrix[float64]
+		tol      float64
+	}{
+		{
+			name:     "Zero matrix",
+			matrix:   Matrix[float64]{{0, 0}, {0, 0}},
+			expected: Identity(2),
+			tol:      1e-15,
+		},
+		{
+			name:     "Diagonal",
+			matrix:   Matrix[float64]{{1, 0, 0}, {0, -2, 0}, {0, 0, 0.5}},
+			expected: Matrix[float64]{{e, 0, 0}, {0, math.Exp(-2), 0}, {0, 0, math.Exp(0.5)}},
+			tol:      1e-14,
+		},
+		{
+			name:     "Nilpotent",
+			matrix:   Matrix[float64]{{0, 1, 0}, {0, 0, 1}, {0, 0, 0}},
+			expected: Matrix[float64]{{1, 1, 0.5}, {0, 1, 1}, {0, 0, 1}},
+			tol:      1e-15,
+		},
+		{
+			name:     "Rotation generator",
+			matrix:   Matrix[float64]{{0, -math.Pi / 2}, {math.Pi / 2, 0}},
+			expected: Matrix[float64]{{0, -1}, {1, 0}},
+			tol:      1e-15,
+		},
+		{
+			// Moler and Van Loan's example, where the Taylor series fails badly
+			name:   "Moler-Van Loan",
+			matrix: Matrix[float64]{{-49, 24}, {-64, 31}},
+			expected: Matrix[float64]{
+				{-0.735758758144755, 0.551819099658099},
+				{-1.471517599088261, 1.103638240715573},
+			},
+			tol: 1e-12,
+		},
+		{
+			name:     "Large norm needs scaling",
+			matrix:   Matrix[float64]{{10, 0}, {0, -10}},
+			expected: Matrix[float64]{{math.Exp(10), 0}, {0, math.Exp(-10)}},
+			tol:      1e-10,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, est, err := Exp(tt.matrix)
+			if err != nil {
+				t.Fatalf("Exp() error = %v", err)
+			}
+			if !matricesAlmostEqual(result, tt.expected, tt.tol*max(1, oneNorm(tt.expected))) {
+				t.Errorf("expected\n%s\ngot\n%s", matrixToString(tt.expected), matrixToString(result))
+			}
+			if est < 0 || est > 1e-10 {
+				t.Errorf("error estimate %g is out of range", est)
+			}
+		})
+	}
+}
+
+func TestExp_Properties(t *testing.T) {
+	for _, n := range []int{1, 4, 10} {
+		t.Run(fmt.Sprintf("%dx%d", n, n), func(t *testing.T) {
+			A := Scale(1/float64(n), randomFloatMatrix(n, n)) // ‖A‖₁ up to 10
+
+			// e^A·e^(-A) = I
+			E, _, err := Exp(A)
+			if err != nil {
+				t.Fatalf("Exp() error = %v", err)
+			}
+			Einv, _, _ := Exp(Scale(-1.0, A))
+			product, _ := Multiply(E, Einv)
+			if !matricesAlmostEqual(product, Identity(n), 1e-10) {
+				t.Errorf("e^A·e^(-A) != I\n%s", matrixToString(product))
+			}
+
+			// e^(2A) = (e^A)²
+			E2, _, _ := Exp(Scale(2.0, A))
+			square, _ := Multiply(E, E)
+			if !matricesAlmostEqual(E2, square, 1e-10*oneNorm(square)) {
+				t.Errorf("e^(2A) != (e^A)²")
+			}
+
+			// det(e^A) = e^tr(A)
+			det, _ := Det(E)
+			tr, _ := Trace(A)
+			if math.Abs(det-math.Exp(tr)) > 1e-10*math.Exp(tr) {
+				t.Errorf("det(e^A) = %g, expected %g", det, math.Exp(tr))
+			}
+		})
+	}
+}
+
+func TestExp_ErrorEstimate(t *testing.T) {
+	// A non-normal matrix with a large norm loses accuracy while squaring
+	A := Matrix[float64]{{-1, 1e6}, {0, -1.5}}
+	_, est, err := Exp(A)
+	if err != nil {
+		t.Fatalf("Exp() error = %v", err)
+	}
+	if est <= 1e-12 {
+		t.Errorf("expected a pessimistic error estimate for a non-normal matrix, got %g", est)
+	}
+}
+
+func TestExp_Errors(t *testing.T) {
+	if _, _, err := Exp(Matrix[int]{}); !errors.Is(err, ErrEmpty) {
+		t.Errorf("expected ErrEmpty, got %v", err)
+	}
+	if _, _, err := Exp(Matrix[int]{{1, 2, 3}, {4, 5, 6}}); !errors.Is(err, ErrNotSquare) {
+		t.Errorf("expected ErrNotSquare, got %v", err)
+	}
+	if _, _, err := Exp(Matrix[float64]{{math.NaN(), 0}, {0, 1}}); !errors.Is(err, ErrDomain) {
+		t.Errorf("expected ErrDomain, got %v", err)
+	}
+	for _, A := range []Matrix[int]{{{1000}}, {{710, 0}, {0, 0}}} {
+		if _, _, err := Exp(A); !errors.Is(err, ErrOverflow) {
+			t.Errorf("Exp(%v): expected ErrOverflow, got %v", A, err)
+		}
+	}
+}
+
+func BenchmarkExp(b *testing.B) {
+	sizes := []int{10, 50, 100}
+
+	for _, size := range sizes {
+		b.Run(fmt.Sprintf("%dx%d", size, size), func(b *testing.B) {
+			A := randomFloatMatrix(size, size)
+			b.ResetTimer()
+			for b.Loop() {
+				_, _, _ = Exp(A)
+			}
+		})
+	}
+}
@@ -0,0 +1,217 @@
+package matrix
+
+import (
+	"fmt"
+	"math"
+	"math/cmplx"
+)
+
+// Funm evaluates a function f at a diagonalizable matrix, f(A) = V·f(Λ)·V⁻¹
+// where A = V·Λ·V⁻¹ is its eigendecomposition.
+//
+// Parameters:
+//   - m: Square input matrix of type Matrix[T] where T is int or float64
+//   - f: Scalar function, applied to every eigenvalue of A
+//
+// Returns:
+//   - Matrix[float64]: The real matrix f(A)
+//   - float64: Estimated relative error of the result in the 1-norm
+//   - error: Returns error if the matrix is invalid, empty (ErrEmpty) or non-square
+//     (ErrNotSquare), if f is nil (ErrInvalidArgument), if f is not finite at an
+//     eigenvalue (ErrDomain), or if the eigenvector basis is too ill-conditioned
+//     for the result to have any correct digits (*ConditionError, matching
+//     ErrIllConditioned)
+//
+// The result is real when f maps complex conjugates to complex conjugates, as
+// cmplx.Exp, cmplx.Cos or any polynomial with real coefficients do. For other
+// functions the imaginary part of V·f(Λ)·V⁻¹ is discarded and the error
+// estimate reports its size.
+//
+// The eigenvectors are computed from the complex Schur form A = U·T·Uᴴ, as
+// the columns of the unit upper triangular Y with T·Y = Y·Λ, so that
+// f(A) = U·Y·f(Λ)·Y⁻¹·Uᴴ. The error estimate n·ε·κ₁(Y)·max|f(λ)| / ‖f(A)‖₁
+// grows with the condition number of the eigenvector basis. For a defective
+// (non-diagonalizable) matrix the basis is numerically singular and Funm
+// returns a *ConditionError carrying κ₁(Y) rather than a wrong matrix: use
+// Exp, Log or Sqrt instead where possible, which do not depend on the
+// eigenvectors.
+//
+// Example:
+//
+//	A := Matrix[float64]{{0, -math.Pi / 2}, {math.Pi / 2, 0}}
+//	R, _, _ := Funm(A, cmplx.Exp)  // Same as Exp(A): [[0, -1], [1, 0]]
+//
+// Time complexity: O(n³) where n is the matrix dimension.
+func Funm[T int | float64](m Matrix[T], f func(complex128) complex128) (Matrix[float64], float64, error) {
+	if f == nil {
+		return nil, 0, fmt.Errorf("function cannot be nil: %w", ErrInvalidArgument)
+	}
+
+	q, t, err := Schur(m)
+	if err != nil {
+		return nil, 0, err
+	}
+	n := len(t)
+	u, tc := complexSchur(q, t)
+
+	// Eigenvectors of T: column k of Y solves (T - λₖI)·y = 0 with yₖ = 1.
+	// A tiny denominator, from a repeated eigenvalue, is replaced by a small
+	// number as in LAPACK's xTREVC, which makes the defective case show up as
+	// an ill-conditioned Y.
+	small := machEps * max(frobenius(t), math.SmallestNonzeroFloat64)
+	y := complexIdentity(n)
+	for k := range n {
+		for i := k - 1; i >= 0; i-- {
+			var s complex128
+			for j := i + 1; j <= k; j++ {
+				s += tc[i][j] * y[j][k]
+			}
+			d := tc[i][i] - tc[k][k]
+			if cmplx.Abs(d) < small {
+				d = complex(small, 0)
+			}
+			y[i][k] = -s / d
+		}
+	}
+
+	// Y⁻¹, which is also unit upper triangular
+	yinv := complexIdentity(n)
+	for k := range n {
+		for i := k - 1; i >= 0; i-- {
+			var s complex128
+			for j := i + 1; j <= k; j++ {
+				s += y[i][j] * yinv[j][k]
+			}
+			yinv[i][k] = -s
+		}
+	}
+
+	fl := make([]complex128, n)
+	fmax := 0.0
+	for i := range n {
+		fl[i] = f(tc[i][i])
+		if cmplx.IsNaN(fl[i]) || cmplx.IsInf(fl[i]) {
+			return nil, 0, fmt.Errorf("function is not finite at eigenvalue %v: %w", tc[i][i], ErrDomain)
+		}
+		fmax = max(fmax, cmplx.Abs(fl[i]))
+	}
+
+	// G = Y·f(Λ)·Y⁻¹ is upper triangular, and f(A) = U·G·Uᴴ
+	g := make([][]complex128, n)
+	for i := range n {
+		g[i] = make([]complex128, n)
+		for k := i; k < n; k++ {
+			for j := i; j <= k; j++ {
+				g[i][k] += y[i][j] * fl[j] * yinv[j][k]
+			}
+		}
+	}
+	ug := make([][]complex128, n)
+	for i := range n {
+		ug[i] = make([]complex128, n)
+		for k := range n {
+			for j := 0; j <= k; j++ {
+				ug[i][k] += u[i][j] * g[j][k]
+			}
+		}
+	}
+	result, _ := NewEmptyMatrix(n, n)
+	imagPart, _ := NewEmptyMatrix(n, n)
+	for i := range n {
+		for k := range n {
+			var s complex128
+			for j := range n {
+				s += ug[i][j] * cmplx.Conj(u[k][j])
+			}
+			result[i][k], imagPart[i][k] = real(s), imag(s)
+		}
+	}
+
+	fnorm, inorm := oneNorm(result), oneNorm(imagPart)
+	if fnorm == 0 {
+		if fmax == 0 && inorm == 0 {
+			return result, 0, nil
+		}
+		return result, math.Inf(1), nil
+	}
+	kappa := complexOneNorm(y) * complexOneNorm(yinv)
+	est := float64(n) * machEps * kappa * fmax / fnorm
+	if est >= 1 {
+		return nil, est, &ConditionError{Cond: kappa}
+	}
+	return result, est + inorm/fnorm, nil
+}
+
+// complexSchur converts the real Schur decomposition A = Q·T·Qᵀ into the
+// complex Schur decomposition A = U·T'·Uᴴ with T' upper triangular, following
+// MATLAB's rsf2csf. Each 2×2 block of T is split by a complex rotation into
+// its two eigenvalues.
+func complexSchur(q, t Matrix[float64]) (u, tc [][]complex128) {
+	n := len(t)
+	u = make([][]complex128, n)
+	tc = make([][]complex128, n)
+	for i := range n {
+		u[i] = make([]complex128, n)
+		tc[i] = make([]complex128, n)
+		for j := range n {
+			u[i][j] = complex(q[i][j], 0)
+			tc[i][j] = complex(t[i][j], 0)
+		}
+	}
+
+	for m := n - 1; m >= 1; m-- {
+		if tc[m][m-1] == 0 {
+			continue
+		}
+
+		// Rotation G = [[c̄, s], [-s, c]] that moves λ - t[m][m] into c
+		a, b, c, d := tc[m-1][m-1], tc[m-1][m], tc[m][m-1], tc[m][m]
+		p := (a - d) / 2
+		mu := p + cmplx.Sqrt(p*p+b*c) // λ - d for an eigenvalue λ of the block
+		r := math.Hypot(cmplx.Abs(mu), cmplx.Abs(c))
+		cs, sn := mu/complex(r, 0), c/complex(r, 0)
+
+		// T = G·T on rows m-1 and m
+		for j := m - 1; j < n; j++ {
+			x, y := tc[m-1][j], tc[m][j]
+			tc[m-1][j] = cmplx.Conj(cs)*x + sn*y
+			tc[m][j] = -sn*x + cs*y
+		}
+		// T = T·Gᴴ on columns m-1 and m, and U = U·Gᴴ
+		for i := 0; i <= m; i++ {
+			x, y := tc[i][m-1], tc[i][m]
+			tc[i][m-1] = x*cs + y*sn
+			tc[i][m] = -x*sn + y*cmplx.Conj(cs)
+		}
+		for i := range n {
+			x, y := u[i][m-1], u[i][m]
+			u[i][m-1] = x*cs + y*sn
+			u[i][m] = -x*sn + y*cmplx.Conj(cs)
+		}
+		tc[m][m-1] = 0
+	}
+	return u, tc
+}
+
+// complexIdentity returns the n×n complex identity matrix.
+func complexIdentity(n int) [][]complex128 {
+	id := make([][]complex128, n)
+	for i := range n {
+		id[i] = make([]complex128, n)
+		id[i][i] = 1
+	}
+	return id
+}
+
+// complexOneNorm returns the largest absolute column sum of a.
+func complexOneNorm(a [][]complex128) float64 {
+	result := 0.0
+	for j := range a {
+		s := 0.0
+		for i := range a {
+			s += cmplx.Abs(a[i][j])
+		}
+		result = max(result, s)
+	}
+	return result
+}
@@ -0,0 +1,161 @@
+package matrix
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/cmplx"
+	"testing"
+)
+
+func TestFunm(t *testing.T) {
+	tests := []struct {
+		name   string
+		matrix Matrix[float64]
+	}{
+		{name: "1x1 matrix", matrix: Matrix[float64]{{0.5}}},
+		{name: "Diagonal", matrix: Matrix[float64]{{1, 0, 0}, {0, -2, 0}, {0, 0, 0.5}}},
+		{name: "Rotation generator", matrix: Matrix[float64]{{0, -math.Pi / 2}, {math.Pi / 2, 0}}},
+		{name: "Complex eigenvalues", matrix: Matrix[float64]{{1, -2, 0}, {2, 1, 1}, {0, 0, 4}}},
+		{name: "Random 6x6", matrix: Scale(1.0/6, randomFloatMatrix(6, 6))},
+		{name: "Random 20x20", matrix: Scale(1.0/20, randomFloatMatrix(20, 20))},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			F, est, err := Funm(tt.matrix, cmplx.Exp)
+			if err != nil {
+				t.Fatalf("Funm() error = %v", err)
+			}
+			E, _, _ := Exp(tt.matrix)
+			if !matricesAlmostEqual(F, E, 1e-10*oneNorm(E)) {
+				t.Errorf("Funm(A, exp) != Exp(A)\n%s\n%s", matrixToString(F), matrixToString(E))
+			}
+			if est > 1e-8 {
+				t.Errorf("error estimate %g is too large", est)
+			}
+		})
+	}
+}
+
+func TestFunm_Polynomial(t *testing.T) {
+	// f(z) = z² - 2z + 3 must agree with A² - 2A + 3I
+	A := Matrix[float64]{{2, 1, 0}, {-1, 2, 3}, {0, 1, -1}}
+	f := func(z complex128) complex128 { return z*z - 2*z + 3 }
+
+	F, _, err := Funm(A, f)
+	if err != nil {
+		t.Fatalf("Funm() error = %v", err)
+	}
+	A2, _ := Multiply(A, A)
+	expected, _ := Subtract(A2, Scale(2.0, A))
+	for i := range expected {
+		expected[i][i] += 3
+	}
+	if !matricesAlmostEqual(F, expected, 1e-12) {
+		t.Errorf("expected\n%s\ngot\n%s", matrixToString(expected), matrixToString(F))
+	}
+}
+
+func TestFunm_ErrorEstimate(t *testing.T) {
+	tests := []struct {
+		name   string
+		matrix Matrix[float64]
+		f      func(complex128) complex128
+	}{
+		{
+			// i·z does not map conjugate eigenvalues to conjugates
+			name:   "Function without a real result",
+			matrix: Matrix[float64]{{0, -1}, {1, 0}},
+			f:      func(z complex128) complex128 { return 1i * z },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, est, err := Funm(tt.matrix, tt.f)
+			if err != nil {
+				t.Fatalf("Funm() error = %v", err)
+			}
+			if est < 0.1 {
+				t.Errorf("expected a large error estimate, got %g", est)
+			}
+		})
+	}
+}
+
+func TestFunm_Errors(t *testing.T) {
+	if _, _, err := Funm(Matrix[int]{}, cmplx.Exp); !errors.Is(err, ErrEmpty) {
+		t.Errorf("expected ErrEmpty, got %v", err)
+	}
+	if _, _, err := Funm(Matrix[int]{{1, 2, 3}, {4, 5, 6}}, cmplx.Exp); !errors.Is(err, ErrNotSquare) {
+		t.Errorf("expected ErrNotSquare, got %v", err)
+	}
+	if _, _, err := Funm(Matrix[int]{{1, 2}, {3, 4}}, nil); !errors.Is(err, ErrInvalidArgument) {
+		t.Errorf("expected ErrInvalidArgument, got %v", err)
+	}
+
+	// A Jordan block has no basis of eigenvectors, so no result is returned
+	// rather than the identity, which is what V·f(Λ)·V⁻¹ gives for z²
+	jordan := Matrix[float64]{{1, 1}, {0, 1}}
+	for name, f := range map[string]func(complex128) complex128{
+		"exp":    cmplx.Exp,
+		"square": func(z complex128) complex128 { return z * z },
+	} {
+		F, _, err := Funm(jordan, f)
+		var condErr *ConditionError
+		if !errors.As(err, &condErr) || !errors.Is(err, ErrIllConditioned) {
+			t.Errorf("%s of a defective matrix: expected *ConditionError, got %v", name, err)
+		}
+		if F != nil {
+			t.Errorf("%s of a defective matrix: expected no result, got %v", name, F)
+		}
+	}
+	if _, _, err := Funm(Matrix[int]{{0, 0}, {0, 1}}, cmplx.Log); !errors.Is(err, ErrDomain) {
+		t.Errorf("expected ErrDomain, got %v", err)
+	}
+}
+
+func TestComplexSchur(t *testing.T) {
+	A := Matrix[float64]{{1, -2, 0, 1}, {2, 1, 1, 0}, {0, 0, 4, -3}, {0, 1, 3, 4}}
+	Q, T, _ := Schur(A)
+	U, Tc := complexSchur(Q, T)
+	n := len(A)
+
+	for i := range n {
+		for j := range i {
+			if Tc[i][j] != 0 {
+				t.Fatalf("T is not upper triangular at (%d, %d): %v", i, j, Tc[i][j])
+			}
+		}
+	}
+
+	// U·T·Uᴴ = A
+	for i := range n {
+		for j := range n {
+			var s complex128
+			for k := range n {
+				for l := range n {
+					s += U[i][k] * Tc[k][l] * cmplx.Conj(U[j][l])
+				}
+			}
+			if cmplx.Abs(s-complex(A[i][j], 0)) > 1e-12 {
+				t.Errorf("U·T·Uᴴ[%d][%d] = %v, expected %g", i, j, s, A[i][j])
+			}
+		}
+	}
+}
+
+func BenchmarkFunm(b *testing.B) {
+	sizes := []int{10, 50, 100}
+
+	for _, size := range sizes {
+		b.Run(fmt.Sprintf("%dx%d", size, size), func(b *testing.B) {
+			A := Scale(1/float64(size), randomFloatMatrix(size, size))
+			b.ResetTimer()
+			for b.Loop() {
+				_, _, _ = Funm(A, cmplx.Exp)
+			}
+		})
+	}
+}
@@ -0,0 +1,131 @@
+package matrix
+
+import "math"
+
+// Hessenberg reduces a square matrix to upper Hessenberg form by an
+// orthogonal similarity transformation.
+//
+// Parameters:
+//   - m: Input matrix of type Matrix[T] where T is int or float64
+//
+// Returns:
+//   - Matrix[float64]: Orthogonal matrix Q
+//   - Matrix[float64]: Upper Hessenberg matrix H with A = Q·H·Qᵀ, which is zero
+//     below its first subdiagonal
+//   - error: Returns error if the matrix is invalid, empty or non-square
+//
+// H has the same eigenvalues as A. The reduction is the first stage of
+// Eigenvalues and Schur, and on its own it makes repeated solves with shifted
+// matrices (A - σI) cheap, as in frequency-response computations.
+//
+// Time complexity: O(n³) where n is the matrix dimension.
+func Hessenberg[T int | float64](m Matrix[T]) (Matrix[float64], Matrix[float64], error) {
+	if err := m.Validate(); err != nil {
+		return nil, nil, err
+	}
+
+	if len(m) == 0 {
+		return nil, nil, ErrEmpty
+	}
+	if !m.isSquare() {
+		return nil, nil, ErrNotSquare
+	}
+
+	h := gtoFloat64Matrix(m)
+	q := hessenbergReduce(h, true)
+	return q, h, nil
+}
+
+// hessenbergReduce reduces the square matrix h to upper Hessenberg form in
+// place with Householder similarity transformations, following the EISPACK
+// routines ORTHES and ORTRAN.
+//
+// When wantQ is set the orthogonal matrix Q with A = Q·H·Qᵀ is accumulated
+// and returned; otherwise the result is nil. Entries below the first
+// subdiagonal of h are zero on return.
+func hessenbergReduce(h Matrix[float64], wantQ bool) Matrix[float64] {
+	n := len(h)
+	ort := make([]float64, n)
+
+	for m := 1; m < n-1; m++ {
+		// Scale the column
+		scale := 0.0
+		for i := m; i < n; i++ {
+			scale += math.Abs(h[i][m-1])
+		}
+		if scale == 0 {
+			continue
+		}
+
+		// Compute the Householder transformation
+		hh := 0.0
+		for i := n - 1; i >= m; i-- {
+			ort[i] = h[i][m-1] / scale
+			hh += ort[i] * ort[i]
+		}
+		g := math.Sqrt(hh)
+		if ort[m] > 0 {
+			g = -g
+		}
+		hh -= ort[m] * g
+		ort[m] -= g
+
+		// Apply the similarity transformation H = (I - u·uᵀ/h)·H·(I - u·uᵀ/h)
+		for j := m; j < n; j++ {
+			f := 0.0
+			for i := n - 1; i >= m; i-- {
+				f += ort[i] * h[i][j]
+			}
+			f /= hh
+			for i := m; i < n; i++ {
+				h[i][j] -= f * ort[i]
+			}
+		}
+		for i := range n {
+			f := 0.0
+			for j := n - 1; j >= m; j-- {
+				f += ort[j] * h[i][j]
+			}
+			f /= hh
+			for j := m; j < n; j++ {
+				h[i][j] -= f * ort[j]
+			}
+		}
+		ort[m] *= scale
+		h[m][m-1] = scale * g
+	}
+
+	// Accumulate the transformations; the reflectors are still stored below
+	// the subdiagonal at this point
+	var q Matrix[float64]
+	if wantQ {
+		q = Identity(n)
+		for m := n - 2; m >= 1; m-- {
+			if h[m][m-1] == 0 {
+				continue
+			}
+			for i := m + 1; i < n; i++ {
+				ort[i] = h[i][m-1]
+			}
+			for j := m; j < n; j++ {
+				g := 0.0
+				for i := m; i < n; i++ {
+					g += ort[i] * q[i][j]
+				}
+				// Double division avoids possible underflow
+				g = (g / ort[m]) / h[m][m-1]
+				for i := m; i < n; i++ {
+					q[i][j] += g * ort[i]
+				}
+			}
+		}
+	}
+
+	for i := 2; i < n; i++ {
+		for j := range i - 1 {
+			h[i][j] = 0
+		}
+	}
+
+	return q
+}
@@ -0,0 +1,81 @@
+package matrix
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestHessenberg(t *testing.T) {
+	tests := []struct {
+		name   string
+		matrix Matrix[float64]
+	}{
+		{name: "1x1 matrix", matrix: Matrix[float64]{{5}}},
+		{name: "2x2 matrix", matrix: Matrix[float64]{{1, 2}, {3, 4}}},
+		{
+			name:   "Already Hessenberg",
+			matrix: Matrix[float64]{{1, 2, 3}, {4, 5, 6}, {0, 7, 8}},
+		},
+		{
+			name:   "Zero column below the subdiagonal",
+			matrix: Matrix[float64]{{1, 2, 3, 4}, {5, 6, 7, 8}, {0, 1, 2, 3}, {0, 4, 5, 6}},
+		},
+		{name: "Random 6x6", matrix: randomFloatMatrix(6, 6)},
+		{name: "Random 40x40", matrix: randomFloatMatrix(40, 40)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			Q, H, err := Hessenberg(tt.matrix)
+			if err != nil {
+				t.Fatalf("Hessenberg() error = %v", err)
+			}
+			n := len(tt.matrix)
+
+			for i := 2; i < n; i++ {
+				for j := range i - 1 {
+					if H[i][j] != 0 {
+						t.Fatalf("H[%d][%d] = %g, expected 0", i, j, H[i][j])
+					}
+				}
+			}
+
+			QtQ, _ := Multiply(Transpose(Q), Q)
+			if !matricesAlmostEqual(QtQ, Identity(n), 1e-13) {
+				t.Errorf("QᵀQ != I\n%s", matrixToString(QtQ))
+			}
+
+			QH, _ := Multiply(Q, H)
+			QHQt, _ := Multiply(QH, Transpose(Q))
+			if !matricesAlmostEqual(QHQt, tt.matrix, 1e-11) {
+				t.Errorf("Q·H·Qᵀ != A\n%s", matrixToString(QHQt))
+			}
+		})
+	}
+}
+
+func TestHessenberg_Errors(t *testing.T) {
+	if _, _, err := Hessenberg(Matrix[int]{}); err == nil {
+		t.Error("expected error for empty matrix")
+	}
+	if _, _, err := Hessenberg(Matrix[int]{{1, 2, 3}, {4, 5, 6}}); err == nil {
+		t.Error("expected error for non-square matrix")
+	}
+	if _, _, err := Hessenberg(Matrix[int]{{1, 2}, {3}}); err == nil {
+		t.Error("expected error for improper matrix")
+	}
+}
+
+func BenchmarkHessenberg(b *testing.B) {
+	sizes := []int{10, 50, 100}
+
+	for _, size := range sizes {
+		b.Run(fmt.Sprintf("%dx%d", size, size), func(b *testing.B) {
+			A := randomFloatMatrix(size, size)
+			b.ResetTimer()
+			for b.Loop() {
+				_, _, _ = Hessenberg(A)
+			}
+		})
+	}
+}
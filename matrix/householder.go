@@ -0,0 +1,150 @@
+package matrix
+
+import "math"
+
+// householder holds a QR factorization A = Q·R computed with Householder
+// reflections, stored in the compact LINPACK form.
+//
+// The k-th reflector H_k = I - v·vᵀ/v[k] is stored below (and on) the
+// diagonal of column k of qr, the strictly upper part of qr holds the
+// off-diagonal entries of R and rdiag holds the diagonal of R. A zero column
+// produces no reflector, in which case rdiag[k] is zero and the reflector is
+// skipped when Q is applied.
+type householder struct {
+	qr    Matrix[float64]
+	rdiag []float64
+	rows  int
+	cols  int
+}
+
+// newHouseholder factorizes a, which is overwritten with the compact factors.
+func newHouseholder(a Matrix[float64]) *householder {
+	rows := len(a)
+	cols := 0
+	if rows > 0 {
+		cols = len(a[0])
+	}
+
+	h := &householder{
+		qr:    a,
+		rdiag: make([]float64, min(rows, cols)),
+		rows:  rows,
+		cols:  cols,
+	}
+
+	for k := range h.rdiag {
+		// Norm of the k-th column below the diagonal, computed without overflow
+		nrm := 0.0
+		for i := k; i < rows; i++ {
+			nrm = math.Hypot(nrm, a[i][k])
+		}
+		if nrm == 0 {
+			continue
+		}
+
+		// Choose the sign that avoids cancellation
+		if a[k][k] < 0 {
+			nrm = -nrm
+		}
+		for i := k; i < rows; i++ {
+			a[i][k] /= nrm
+		}
+		a[k][k] += 1
+
+		// Apply the reflector to the remaining columns
+		for j := k + 1; j < cols; j++ {
+			s := 0.0
+			for i := k; i < rows; i++ {
+				s += a[i][k] * a[i][j]
+			}
+			s = -s / a[k][k]
+			for i := k; i < rows; i++ {
+				a[i][j] += s * a[i][k]
+			}
+		}
+		h.rdiag[k] = -nrm
+	}
+
+	return h
+}
+
+// reflect applies the k-th reflector to x in place.
+func (h *householder) reflect(k int, x []float64) {
+	s := 0.0
+	for i := k; i < h.rows; i++ {
+		s += h.qr[i][k] * x[i]
+	}
+	s = -s / h.qr[k][k]
+	for i := k; i < h.rows; i++ {
+		x[i] += s * h.qr[i][k]
+	}
+}
+
+// applyQT overwrites x (of length rows) with Qᵀ·x.
+func (h *householder) applyQT(x []float64) {
+	for k := range h.rdiag {
+		if h.rdiag[k] != 0 {
+			h.reflect(k, x)
+		}
+	}
+}
+
+// applyQ overwrites x (of length rows) with Q·x.
+func (h *householder) applyQ(x []float64) {
+	for k := len(h.rdiag) - 1; k >= 0; k-- {
+		if h.rdiag[k] != 0 {
+			h.reflect(k, x)
+		}
+	}
+}
+
+// r returns the upper triangular (or trapezoidal) factor R with the given
+// number of rows, which must be at least min(rows, cols).
+func (h *householder) r(rows int) Matrix[float64] {
+	r, _ := NewEmptyMatrix(rows, h.cols)
+	for i := range h.rdiag {
+		r[i][i] = h.rdiag[i]
+		copy(r[i][i+1:], h.qr[i][i+1:])
+	}
+	return r
+}
+
+// rcondDiag returns the ratio of the smallest to the largest diagonal entry
+// of R in magnitude. It is a cheap lower-quality estimate of the reciprocal
+// condition number and is zero when R is exactly singular.
+func (h *householder) rcondDiag() float64 {
+	return diagRatio(h.rdiag)
+}
+
+// diagRatio returns min|d[i]| / max|d[i]|, or 0 for an empty or zero diagonal.
+func diagRatio(d []float64) float64 {
+	if len(d) == 0 {
+		return 0
+	}
+	lo, hi := math.Inf(1), 0.0
+	for _, v := range d {
+		v = math.Abs(v)
+		lo = min(lo, v)
+		hi = max(hi, v)
+	}
+	if hi == 0 {
+		return 0
+	}
+	return lo / hi
+}
+
+// q returns the orthogonal factor with the given number of columns, which
+// must be between min(rows, cols) and rows.
+func (h *householder) q(cols int) Matrix[float64] {
+	q, _ := NewEmptyMatrix(h.rows, cols)
+	e := make([]float64, h.rows)
+	for j := range cols {
+		clear(e)
+		e[j] = 1
+		h.applyQ(e)
+		for i := range h.rows {
+			q[i][j] = e[i]
+		}
+	}
+	return q
+}
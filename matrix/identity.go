@@ -15,9 +15,9 @@ package matrix
 // The function returns a Matrix[float64] rather than a generic type for consistency
 // with other matrix operations that involve floating-point computation.
 func Identity(n int) Matrix[float64] {
-	m := make(Matrix[float64], n)
-	for i := range n {
-		m[i] = make([]float64, n)
+	d := &Dense{rows: n, cols: n, stride: n, data: make([]float64, n*n)}
+	m := d.Matrix()
+	for i := range m {
 		m[i][i] = 1
 	}
 	return m
@@ -1,95 +1,49 @@
 package matrix
 
-import (
-	"errors"
-	"math"
-)
+import "github.com/rickykimani/linalg/internal/negligible"
 
-// Inverse calculates the inverse of a matrix using Gauss-Jordan elimination.
+// Inverse calculates the inverse of a matrix using LU decomposition.
 //
 // Parameters:
 //   - m: A square matrix of type Matrix[T] where T is int or float64
+//   - tol: Optional tolerance for singularity and ill-conditioning, as in Solve
 //
 // Returns:
 //   - Matrix[float64]: The inverse of the input matrix
-//   - error: Returns an error if the matrix is non-square or singular (not invertible)
+//   - error: Returns an error if the matrix is non-square (ErrNotSquare), singular
+//     (ErrSingular), or too badly conditioned for the inverse to have any correct
+//     digits (*ConditionError, matching ErrIllConditioned)
 //
-// The function uses the Gauss-Jordan elimination method with an augmented matrix [A|I]
-// to transform A into the identity matrix, simultaneously transforming I into A⁻¹.
-// For numerical stability, the function performs row swapping when a pivot element is zero.
+// The function factorizes P·A = L·U with partial pivoting (see NewLU) and then
+// solves A·X = I one column at a time by forward and back substitution.
+// When several inverses or solves with the same matrix are needed, factorize
+// once with NewLU and reuse the result.
+//
+// As in Solve, the 1-norm condition number is estimated from the factorization
+// (see LU.CondEst); a reciprocal condition number within the tolerance (n·ε by
+// default) catches nearly singular matrices whose pivots are not small enough
+// to be flagged as singular.
 //
 // Note: The inverse only exists for square matrices with non-zero determinant (non-singular).
-func Inverse[T int | float64](m Matrix[T]) (Matrix[float64], error) {
+func Inverse[T int | float64](m Matrix[T], tol ...Tolerance) (Matrix[float64], error) {
 	// Validate input
 	if err := m.Validate(); err != nil {
 		return nil, err
 	}
 	if !m.isSquare() {
-		return nil, errors.New("cannot invert a non-square matrix")
+		return nil, ErrNotSquare
 	}
-	n := len(m)
 
-	// Create augmented matrix [A | I]
-	A := make(Matrix[float64], n)
-	for i := range n {
-		A[i] = make([]float64, 2*n)
-		for j := range n {
-			A[i][j] = float64(m[i][j])
-		}
-		A[i][n+i] = 1 // Identity matrix in right half
+	f, err := NewLU(m, tol...)
+	if err != nil {
+		return nil, err // Dead, shape already checked
 	}
 
-	// Gauss-Jordan elimination
-	const epsilon = 1e-10 // Small value for numerical stability
-
-	for i := range n {
-		// Find row with maximum pivot (partial pivoting)
-		maxRow := i
-		maxVal := math.Abs(A[i][i])
-
-		for k := i + 1; k < n; k++ {
-			absVal := math.Abs(A[k][i])
-			if absVal > maxVal {
-				maxRow = k
-				maxVal = absVal
-			}
-		}
-
-		// Check if matrix is singular
-		if maxVal < epsilon {
-			return nil, errors.New("matrix is singular")
-		}
-
-		// Swap rows if needed
-		if maxRow != i {
-			A[i], A[maxRow] = A[maxRow], A[i]
-		}
-
-		// Normalize the pivot row
-		pivot := A[i][i]
-		for j := range 2 * n {
-			A[i][j] /= pivot
-		}
-
-		// Eliminate column i from all other rows
-		for k := range n {
-			if k != i {
-				factor := A[k][i]
-				for j := range 2 * n {
-					A[k][j] -= factor * A[i][j]
-				}
-			}
-		}
+	if f.singular {
+		return nil, ErrSingular
 	}
-
-	// Extract inverse matrix
-	inverse := make(Matrix[float64], n)
-	for i := range n {
-		inverse[i] = make([]float64, n)
-		for j := range n {
-			inverse[i][j] = A[i][n+j]
-		}
+	if cond := f.CondEst(); cond*negligible.Threshold(tol, epsTol(len(m)), 1) > 1 {
+		return nil, &ConditionError{Cond: cond}
 	}
-
-	return inverse, nil
+	return f.Inverse()
 }
@@ -1,6 +1,7 @@
 package matrix
 
 import (
+	"errors"
 	"fmt"
 	"testing"
 )
@@ -76,6 +77,7 @@ func TestInverse(t *testing.T) {
 		tests := []struct {
 			name     string
 			matrix   Matrix[int]
+			target   error
 			errorMsg string
 		}{
 			{
@@ -92,7 +94,7 @@ func TestInverse(t *testing.T) {
 					{2, 4},
 					{1, 2}, // Row 2 is half of row 1
 				},
-				errorMsg: "matrix is singular",
+				target: ErrSingular,
 			},
 			{
 				name: "Non-square matrix",
@@ -100,7 +102,7 @@ func TestInverse(t *testing.T) {
 					{1, 2, 3},
 					{4, 5, 6},
 				},
-				errorMsg: "cannot invert a non-square matrix",
+				target: ErrNotSquare,
 			},
 		}
 
@@ -110,7 +112,10 @@ func TestInverse(t *testing.T) {
 				if err == nil {
 					t.Fatal("expected error, got nil")
 				}
-				if err.Error() != tt.errorMsg {
+				if tt.target != nil && !errors.Is(err, tt.target) {
+					t.Errorf("expected %v, got %v", tt.target, err)
+				}
+				if tt.errorMsg != "" && err.Error() != tt.errorMsg {
 					t.Errorf("expected error message %q, got %q", tt.errorMsg, err.Error())
 				}
 			})
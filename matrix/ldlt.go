@@ -0,0 +1,370 @@
+package matrix
+
+import (
+	"math"
+
+	"github.com/rickykimani/linalg/internal/negligible"
+	"github.com/rickykimani/linalg/vectors"
+)
+
+// bunchKaufmanAlpha is the pivoting threshold (1 + √17)/8 that minimizes
+// element growth in the Bunch–Kaufman factorization.
+var bunchKaufmanAlpha = (1 + math.Sqrt(17)) / 8
+
+// LDLT holds the factorization P·A·Pᵀ = L·D·Lᵀ of a symmetric, possibly
+// indefinite, matrix.
+//
+// P is a permutation matrix, L is unit lower triangular and D is block
+// diagonal with 1×1 and 2×2 blocks. The 2×2 blocks let the factorization
+// handle indefinite matrices such as [[0, 1], [1, 0]] that have no stable
+// factorization with a purely diagonal D. Create it with NewLDLT and reuse it
+// for any number of solves.
+type LDLT struct {
+	l        Matrix[float64]
+	d        Matrix[float64]
+	perm     []int
+	block    []int // Size of the pivot block starting at each index, 0 inside a 2×2 block
+	singular bool
+}
+
+// NewLDLT computes the pivoted LDLᵀ factorization of a symmetric matrix using
+// Bunch–Kaufman diagonal pivoting.
+//
+// Parameters:
+//   - m: Symmetric input matrix of type Matrix[T] where T is int or float64
+//   - tol: Optional tolerance for singularity; a pivot no larger than it,
+//     relative to ‖A‖₁, marks the matrix as singular (default n·ε). It also
+//     replaces the default 1e-10 of the symmetry check, as for NewCholesky
+//
+// Returns:
+//   - *LDLT: The factorization P·A·Pᵀ = L·D·Lᵀ
+//   - error: An error if the matrix is invalid, empty, non-square or not
+//     symmetric (ErrNotSymmetric)
+//
+// Like NewLU, a singular matrix is not an error: the factorization is still
+// completed and IsSingular reports true. Unlike Cholesky, the matrix does not
+// need to be positive definite; Inertia reports how many eigenvalues are
+// positive, negative and zero.
+//
+// Time complexity: O(n³/3) where n is the matrix dimension.
+func NewLDLT[T int | float64](m Matrix[T], tol ...Tolerance) (*LDLT, error) {
+	if err := m.Validate(); err != nil {
+		return nil, err
+	}
+
+	n := len(m)
+	if n == 0 {
+		return nil, ErrEmpty
+	}
+
+	if !m.isSquare() {
+		return nil, ErrNotSquare
+	}
+
+	if !m.isSymmetric(tol) {
+		return nil, ErrNotSymmetric
+	}
+
+	a := gtoFloat64Matrix(m)
+	anorm, _ := Norm(a, Norm1)
+	limit := negligible.Threshold(tol, epsTol(n), anorm)
+	f := &LDLT{
+		l:     Identity(n),
+		perm:  make([]int, n),
+		block: make([]int, n),
+	}
+	f.d, _ = NewEmptyMatrix(n, n)
+	for i := range n {
+		f.perm[i] = i
+	}
+
+	for k := 0; k < n; {
+		// Largest off-diagonal entry in column k
+		absakk := math.Abs(a[k][k])
+		imax, colmax := k, 0.0
+		for i := k + 1; i < n; i++ {
+			if v := math.Abs(a[i][k]); v > colmax {
+				imax, colmax = i, v
+			}
+		}
+
+		if max(absakk, colmax) <= limit {
+			// The column is already zero: a zero 1×1 pivot
+			f.d[k][k] = a[k][k]
+			f.block[k] = 1
+			f.singular = true
+			k++
+			continue
+		}
+
+		size, kp := 1, k
+		if absakk < bunchKaufmanAlpha*colmax {
+			// Largest off-diagonal entry in row imax of the trailing block
+			rowmax := 0.0
+			for j := k; j < n; j++ {
+				if j != imax {
+					rowmax = max(rowmax, math.Abs(a[imax][j]))
+				}
+			}
+
+			switch {
+			case absakk*rowmax >= bunchKaufmanAlpha*colmax*colmax:
+				// a[k][k] is still an acceptable 1×1 pivot
+			case math.Abs(a[imax][imax]) >= bunchKaufmanAlpha*rowmax:
+				kp = imax // Use a[imax][imax] as a 1×1 pivot
+			default:
+				size, kp = 2, imax // Use a 2×2 pivot with rows k and imax
+			}
+		}
+
+		// Move the chosen pivot row into position
+		if target := k + size - 1; kp != target {
+			symmetricSwap(a, target, kp)
+			f.perm[target], f.perm[kp] = f.perm[kp], f.perm[target]
+			for j := range k {
+				f.l[target][j], f.l[kp][j] = f.l[kp][j], f.l[target][j]
+			}
+		}
+
+		f.block[k] = size
+		if size == 1 {
+			d := a[k][k]
+			f.d[k][k] = d
+			if math.Abs(d) <= limit {
+				f.singular = true
+			}
+
+			// Schur complement update: A₂₂ -= c·cᵀ/d
+			for i := k + 1; i < n; i++ {
+				f.l[i][k] = a[i][k] / d
+			}
+			for i := k + 1; i < n; i++ {
+				for j := k + 1; j < n; j++ {
+					a[i][j] -= f.l[i][k] * a[j][k]
+				}
+			}
+		} else {
+			d11, d21, d22 := a[k][k], a[k+1][k], a[k+1][k+1]
+			f.d[k][k], f.d[k][k+1] = d11, d21
+			f.d[k+1][k], f.d[k+1][k+1] = d21, d22
+			det := d11*d22 - d21*d21
+			// An eigenvalue of the block within the tolerance makes it singular
+			if math.Abs(det) <= limit*(math.Abs(d11)+math.Abs(d21)+math.Abs(d22)) {
+				f.singular = true
+			}
+
+			// Schur complement update: A₂₂ -= C·D⁻¹·Cᵀ
+			for i := k + 2; i < n; i++ {
+				c1, c2 := a[i][k], a[i][k+1]
+				f.l[i][k] = (c1*d22 - c2*d21) / det
+				f.l[i][k+1] = (c2*d11 - c1*d21) / det
+			}
+			for i := k + 2; i < n; i++ {
+				for j := k + 2; j < n; j++ {
+					a[i][j] -= f.l[i][k]*a[j][k] + f.l[i][k+1]*a[j][k+1]
+				}
+			}
+		}
+
+		k += size
+	}
+
+	return f, nil
+}
+
+// symmetricSwap exchanges rows i and j and then columns i and j of a, which
+// keeps a symmetric matrix symmetric.
+func symmetricSwap(a Matrix[float64], i, j int) {
+	a[i], a[j] = a[j], a[i]
+	swapColumns(a, i, j)
+}
+
+// Size returns the dimension n of the factorized n×n matrix.
+func (f *LDLT) Size() int {
+	return len(f.l)
+}
+
+// IsSingular reports whether a zero pivot was encountered during factorization.
+func (f *LDLT) IsSingular() bool {
+	return f.singular
+}
+
+// L returns a copy of the unit lower triangular factor.
+func (f *LDLT) L() Matrix[float64] {
+	return cloneMatrix(f.l)
+}
+
+// D returns a copy of the block diagonal factor, with 1×1 and symmetric 2×2
+// blocks on the diagonal.
+func (f *LDLT) D() Matrix[float64] {
+	return cloneMatrix(f.d)
+}
+
+// Pivot returns the symmetric permutation as a slice p such that row and
+// column i of P·A·Pᵀ are row and column p[i] of A.
+//
+// The returned slice is a copy and may be modified freely.
+func (f *LDLT) Pivot() []int {
+	p := make([]int, len(f.perm))
+	copy(p, f.perm)
+	return p
+}
+
+// P returns the permutation matrix P of the factorization P·A·Pᵀ = L·D·Lᵀ.
+func (f *LDLT) P() Matrix[float64] {
+	n := len(f.perm)
+	p, _ := NewEmptyMatrix(n, n)
+	for i, r := range f.perm {
+		p[i][r] = 1
+	}
+	return p
+}
+
+// Det returns the determinant of the factorized matrix, the product of the
+// determinants of the diagonal blocks of D.
+func (f *LDLT) Det() float64 {
+	det := 1.0
+	for k := 0; k < len(f.d); k += f.block[k] {
+		if f.block[k] == 1 {
+			det *= f.d[k][k]
+		} else {
+			det *= f.d[k][k]*f.d[k+1][k+1] - f.d[k+1][k]*f.d[k+1][k]
+		}
+	}
+	return det
+}
+
+// Inertia returns the number of positive, negative and zero eigenvalues of the
+// factorized matrix.
+//
+// By Sylvester's law of inertia these equal the counts for D, which are read
+// off its blocks without computing any eigenvalues.
+func (f *LDLT) Inertia() (pos, neg, zero int) {
+	count := func(v float64) {
+		switch {
+		case v > 0:
+			pos++
+		case v < 0:
+			neg++
+		default:
+			zero++
+		}
+	}
+
+	for k := 0; k < len(f.d); k += f.block[k] {
+		if f.block[k] == 1 {
+			count(f.d[k][k])
+			continue
+		}
+
+		// Eigenvalues of a symmetric 2×2 block [[a, b], [b, c]]
+		a, b, c := f.d[k][k], f.d[k+1][k], f.d[k+1][k+1]
+		mid, rad := (a+c)/2, math.Hypot((a-c)/2, b)
+		count(mid + rad)
+		count(mid - rad)
+	}
+	return pos, neg, zero
+}
+
+// SolveVector solves the linear system A·x = b for x.
+//
+// Parameters:
+//   - b: Right-hand side vector with one element per row of A
+//
+// Returns:
+//   - vectors.Vector[float64]: The solution vector x
+//   - error: An error if the matrix is singular or the vector length does not match
+//
+// Time complexity: O(n²) per solve, since the factorization is reused.
+func (f *LDLT) SolveVector(b vectors.Vector[float64]) (vectors.Vector[float64], error) {
+	n := len(f.l)
+	if len(b) != n {
+		return nil, dimensionError("LDLT.SolveVector", [2]int{n, n}, [2]int{len(b), 1})
+	}
+	if f.singular {
+		return nil, ErrSingular
+	}
+
+	x := make(vectors.Vector[float64], n)
+	f.solveInto(x, b)
+	return x, nil
+}
+
+// SolveMatrix solves the linear system A·X = B for X, treating every column
+// of B as a separate right-hand side.
+//
+// Parameters:
+//   - b: Right-hand side matrix with as many rows as A
+//
+// Returns:
+//   - Matrix[float64]: The solution matrix X with the same shape as B
+//   - error: An error if the matrix is singular, B is invalid or the dimensions do not match
+func (f *LDLT) SolveMatrix(b Matrix[float64]) (Matrix[float64], error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+
+	n := len(f.l)
+	if len(b) != n {
+		return nil, dimensionError("LDLT.SolveMatrix", [2]int{n, n}, b.shape())
+	}
+	if f.singular {
+		return nil, ErrSingular
+	}
+
+	cols := b.Cols()
+	x, _ := NewEmptyMatrix(n, cols)
+	rhs := make([]float64, n)
+	col := make([]float64, n)
+	for j := range cols {
+		for i := range n {
+			rhs[i] = b[i][j]
+		}
+		f.solveInto(col, rhs)
+		for i := range n {
+			x[i][j] = col[i]
+		}
+	}
+	return x, nil
+}
+
+// solveInto writes the solution of A·x = b into x.
+func (f *LDLT) solveInto(x, b []float64) {
+	n := len(f.l)
+
+	// y = P·b
+	y := make([]float64, n)
+	for i, r := range f.perm {
+		y[i] = b[r]
+	}
+
+	// Forward substitution with unit lower triangular L
+	for i := range n {
+		for k := range i {
+			y[i] -= f.l[i][k] * y[k]
+		}
+	}
+
+	// Block diagonal solve with D
+	for k := 0; k < n; k += f.block[k] {
+		if f.block[k] == 1 {
+			y[k] /= f.d[k][k]
+			continue
+		}
+		a, b, c := f.d[k][k], f.d[k+1][k], f.d[k+1][k+1]
+		det := a*c - b*b
+		y[k], y[k+1] = (c*y[k]-b*y[k+1])/det, (a*y[k+1]-b*y[k])/det
+	}
+
+	// Back substitution with Lᵀ
+	for i := n - 1; i >= 0; i-- {
+		for k := i + 1; k < n; k++ {
+			y[i] -= f.l[k][i] * y[k]
+		}
+	}
+
+	// x = Pᵀ·y
+	for i, r := range f.perm {
+		x[r] = y[i]
+	}
+}
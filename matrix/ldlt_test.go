@@ -0,0 +1,150 @@
+package matrix
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/rickykimani/linalg/vectors"
+)
+
+func TestNewLDLT(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    Matrix[float64]
+		inertia  [3]int
+		wantDet  float64
+		has2x2   bool
+		singular bool
+	}{
+		{
+			name: "positive definite",
+			input: Matrix[float64]{
+				{4, 12, -16},
+				{12, 37, -43},
+				{-16, -43, 98},
+			},
+			inertia: [3]int{3, 0, 0},
+			wantDet: 36,
+		},
+		{
+			name: "zero diagonal needs 2x2 pivot",
+			input: Matrix[float64]{
+				{0, 1},
+				{1, 0},
+			},
+			inertia: [3]int{1, 1, 0},
+			wantDet: -1,
+			has2x2:  true,
+		},
+		{
+			name: "indefinite 4x4",
+			input: Matrix[float64]{
+				{1, 2, 3, 4},
+				{2, -1, 4, 0},
+				{3, 4, 0, 1},
+				{4, 0, 1, -2},
+			},
+			inertia: [3]int{2, 2, 0},
+		},
+		{
+			name: "singular",
+			input: Matrix[float64]{
+				{1, 1, 0},
+				{1, 1, 0},
+				{0, 0, -2},
+			},
+			inertia:  [3]int{1, 1, 1},
+			singular: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := NewLDLT(tt.input)
+			if err != nil {
+				t.Fatalf("NewLDLT() error = %v", err)
+			}
+			if f.IsSingular() != tt.singular {
+				t.Fatalf("IsSingular() = %v, want %v", f.IsSingular(), tt.singular)
+			}
+
+			// P·A·Pᵀ = L·D·Lᵀ
+			P := f.P()
+			PA, _ := Multiply(P, tt.input)
+			PAPt, _ := Multiply(PA, Transpose(P))
+			LD, _ := Multiply(f.L(), f.D())
+			LDLt, _ := Multiply(LD, Transpose(f.L()))
+			if !matricesAlmostEqual(PAPt, LDLt, 1e-12) {
+				t.Errorf("P·A·Pᵀ != L·D·Lᵀ\nP·A·Pᵀ:\n%sL·D·Lᵀ:\n%s", matrixToString(PAPt), matrixToString(LDLt))
+			}
+
+			D := f.D()
+			found2x2 := false
+			for i := 1; i < len(D); i++ {
+				if D[i][i-1] != 0 {
+					found2x2 = true
+				}
+			}
+			if tt.has2x2 && !found2x2 {
+				t.Error("expected a 2×2 pivot block in D")
+			}
+
+			pos, neg, zero := f.Inertia()
+			if got := [3]int{pos, neg, zero}; got != tt.inertia {
+				t.Errorf("Inertia() = %v, want %v", got, tt.inertia)
+			}
+
+			if tt.singular {
+				if _, err := f.SolveVector(make(vectors.Vector[float64], len(tt.input))); !errors.Is(err, ErrSingular) {
+					t.Errorf("expected ErrSingular, got %v", err)
+				}
+				if f.Det() != 0 {
+					t.Errorf("Det() = %v, want 0", f.Det())
+				}
+				return
+			}
+
+			wantDet, _ := Det(tt.input)
+			if math.Abs(f.Det()-wantDet) > 1e-9 {
+				t.Errorf("Det() = %v, want %v", f.Det(), wantDet)
+			}
+
+			want := make(vectors.Vector[float64], len(tt.input))
+			for i := range want {
+				want[i] = float64(i + 1)
+			}
+			b, _ := MultiplyVector(tt.input, want)
+			x, err := f.SolveVector(b)
+			if err != nil {
+				t.Fatalf("SolveVector() error = %v", err)
+			}
+			for i := range want {
+				if math.Abs(x[i]-want[i]) > 1e-10 {
+					t.Errorf("x[%d] = %v, want %v", i, x[i], want[i])
+				}
+			}
+
+			X, err := f.SolveMatrix(Identity(len(tt.input)))
+			if err != nil {
+				t.Fatalf("SolveMatrix() error = %v", err)
+			}
+			product, _ := Multiply(tt.input, X)
+			if !matricesAlmostEqual(product, Identity(len(tt.input)), 1e-10) {
+				t.Errorf("A·X != I\n%s", matrixToString(product))
+			}
+		})
+	}
+}
+
+func TestNewLDLT_Errors(t *testing.T) {
+	if _, err := NewLDLT(Matrix[int]{{1, 2}, {3, 4}}); !errors.Is(err, ErrNotSymmetric) {
+		t.Errorf("expected ErrNotSymmetric, got %v", err)
+	}
+	if _, err := NewLDLT(Matrix[int]{}); err == nil {
+		t.Error("expected error for empty matrix")
+	}
+	if _, err := NewLDLT(Matrix[int]{{1, 2}}); err == nil {
+		t.Error("expected error for non-square matrix")
+	}
+}
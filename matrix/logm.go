@@ -0,0 +1,169 @@
+package matrix
+
+import (
+	"fmt"
+	"math"
+)
+
+// logPadeTheta[m-1] is the largest 1-norm of X for which the [m/m] Padé
+// approximant to log(I + X) is accurate to the unit roundoff (Higham, 2008).
+var logPadeTheta = []float64{1.10e-5, 1.82e-3, 1.62e-2, 5.39e-2, 1.14e-1, 1.87e-1, 2.64e-1, 3.40e-1}
+
+// logMaxSqrt bounds the number of square roots taken by Log. Each halves the
+// logarithm, so even matrices with eigenvalues near the limits of float64
+// need far fewer.
+const logMaxSqrt = 100
+
+// Log computes the principal logarithm of a matrix, the unique X with e^X = A
+// whose eigenvalues all have imaginary part in (-π, π).
+//
+// Parameters:
+//   - m: Square input matrix of type Matrix[T] where T is int or float64
+//
+// Returns:
+//   - Matrix[float64]: The principal logarithm X
+//   - float64: Relative residual ‖e^X - A‖₁ / ‖A‖₁, an estimate of the error
+//   - error: Returns error if the matrix is invalid, empty (ErrEmpty) or non-square
+//     (ErrNotSquare), or if it has a zero or negative real eigenvalue and
+//     therefore no real principal logarithm (ErrDomain)
+//
+// The inverse scaling and squaring method is used. With A = Q·T·Qᵀ, square
+// roots of the quasi upper triangular T are taken (see Sqrt) until T^(1/2ᵏ)
+// is close to the identity. The logarithm of T^(1/2ᵏ) = I + X is then
+// evaluated with a Padé approximant, in the partial fraction form given by
+// Gauss–Legendre quadrature of log(I + X) = ∫₀¹ X·(I + t·X)⁻¹ dt, and
+// multiplied by 2ᵏ. Finally X = Q·log(T)·Qᵀ.
+//
+// Example:
+//
+//	A := Matrix[float64]{{0, -1}, {1, 0}}  // Rotation by 90°
+//	X, _, _ := Log(A)                      // Returns [[0, -π/2], [π/2, 0]]
+//
+// Time complexity: O(n³·(k + m)) for k square roots and Padé degree m.
+func Log[T int | float64](m Matrix[T]) (Matrix[float64], float64, error) {
+	q, t, err := Schur(m)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	n := len(t)
+	for k := 0; k < n; {
+		size := schurBlockSize(t, k)
+		if λ := schurBlockEigenvalue(t, k, size); imag(λ) == 0 && real(λ) <= 0 {
+			return nil, 0, fmt.Errorf("eigenvalue %g is not positive: %w", real(λ), ErrDomain)
+		}
+		if size == 2 && t[k][k]*t[k+1][k+1]-t[k][k+1]*t[k+1][k] <= 0 {
+			// A 2×2 block with real eigenvalues, one of them not positive
+			return nil, 0, fmt.Errorf("eigenvalue at row %d is not positive: %w", k, ErrDomain)
+		}
+		k += size
+	}
+
+	// Square roots until T^(1/2ᵏ) - I is within the largest Padé bound
+	x := minusIdentity(t)
+	sqrts := 0
+	for oneNorm(x) > logPadeTheta[len(logPadeTheta)-1] {
+		if sqrts == logMaxSqrt {
+			return nil, 0, fmt.Errorf("logarithm: %w", ErrNoConvergence)
+		}
+		if t, err = sqrtQuasiTriangular(t); err != nil {
+			return nil, 0, err
+		}
+		x = minusIdentity(t)
+		sqrts++
+	}
+
+	// Lowest Padé degree that is accurate for X
+	deg := len(logPadeTheta)
+	for i, theta := range logPadeTheta {
+		if oneNorm(x) <= theta {
+			deg = i + 1
+			break
+		}
+	}
+
+	l, err := logPadeApprox(x, deg)
+	if err != nil {
+		return nil, 0, err
+	}
+	for _, row := range l {
+		for j := range row {
+			row[j] = math.Ldexp(row[j], sqrts)
+		}
+	}
+
+	ql, _ := Multiply(q, l)
+	result, _ := Multiply(ql, Transpose(q))
+
+	// Relative residual of e^X = A
+	a := gtoFloat64Matrix(m)
+	e, _, err := Exp(result)
+	if err != nil {
+		return nil, 0, err
+	}
+	res, _ := Subtract(e, a)
+	return result, oneNorm(res) / oneNorm(a), nil
+}
+
+// minusIdentity returns t - I.
+func minusIdentity(t Matrix[float64]) Matrix[float64] {
+	x := cloneMatrix(t)
+	for i := range x {
+		x[i][i]--
+	}
+	return x
+}
+
+// logPadeApprox evaluates the [m/m] Padé approximant to log(I + X) as the
+// m-point Gauss–Legendre rule Σ wⱼ·X·(I + tⱼ·X)⁻¹.
+func logPadeApprox(x Matrix[float64], m int) (Matrix[float64], error) {
+	n := len(x)
+	nodes, weights := gaussLegendre(m)
+
+	result, _ := NewEmptyMatrix(n, n)
+	for j, tj := range nodes {
+		d := Identity(n)
+		addScaled(d, tj, x)
+		f, _ := NewLU(d)
+		y, err := f.SolveMatrix(x)
+		if err != nil {
+			return nil, fmt.Errorf("logarithm: %w", err)
+		}
+		addScaled(result, weights[j], y)
+	}
+	return result, nil
+}
+
+// gaussLegendre returns the nodes and weights of the m-point Gauss–Legendre
+// quadrature rule on [0, 1].
+//
+// The nodes are the roots of the Legendre polynomial Pₘ, found by Newton's
+// method from the Chebyshev-like starting guesses cos(π·(i + 3/4)/(m + 1/2)).
+func gaussLegendre(m int) (nodes, weights []float64) {
+	nodes = make([]float64, m)
+	weights = make([]float64, m)
+	for i := range (m + 1) / 2 {
+		z := math.Cos(math.Pi * (float64(i) + 0.75) / (float64(m) + 0.5))
+		var dp float64
+		for range 100 {
+			// Pₘ(z) by the three-term recurrence, and its derivative
+			p, prev := 1.0, 0.0
+			for j := 1; j <= m; j++ {
+				p, prev = ((2*float64(j)-1)*z*p-(float64(j)-1)*prev)/float64(j), p
+			}
+			dp = float64(m) * (z*p - prev) / (z*z - 1)
+
+			dz := p / dp
+			z -= dz
+			if math.Abs(dz) <= machEps {
+				break
+			}
+		}
+
+		// Map from [-1, 1] to [0, 1], which halves the weights
+		w := 1 / ((1 - z*z) * dp * dp)
+		nodes[i], nodes[m-1-i] = (1-z)/2, (1+z)/2
+		weights[i], weights[m-1-i] = w, w
+	}
+	return nodes, weights
+}
@@ -0,0 +1,142 @@
+package matrix
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestLog(t *testing.T) {
+	tests := []struct {
+		name     string
+		matrix   Matrix[float64]
+		expected Matrix[float64] // nil to only check e^X = A
+	}{
+		{
+			name:     "Identity",
+			matrix:   Identity(3),
+			expected: Matrix[float64]{{0, 0, 0}, {0, 0, 0}, {0, 0, 0}},
+		},
+		{
+			name:     "Diagonal",
+			matrix:   Matrix[float64]{{math.E, 0}, {0, 1e-3}},
+			expected: Matrix[float64]{{1, 0}, {0, math.Log(1e-3)}},
+		},
+		{
+			name:     "Rotation",
+			matrix:   Matrix[float64]{{0, -1}, {1, 0}},
+			expected: Matrix[float64]{{0, -math.Pi / 2}, {math.Pi / 2, 0}},
+		},
+		{
+			name:     "Jordan block",
+			matrix:   Matrix[float64]{{1, 1}, {0, 1}},
+			expected: Matrix[float64]{{0, 1}, {0, 0}},
+		},
+		{
+			name:   "Wide spread of eigenvalues",
+			matrix: Matrix[float64]{{1e4, 1, 0}, {0, 1, 1}, {0, 0, 1e-4}},
+		},
+		{
+			name:   "Complex eigenvalues",
+			matrix: Matrix[float64]{{1, -2, 0}, {2, 1, 1}, {0, 0, 4}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			X, est, err := Log(tt.matrix)
+			if err != nil {
+				t.Fatalf("Log() error = %v", err)
+			}
+			if tt.expected != nil && !matricesAlmostEqual(X, tt.expected, 1e-12) {
+				t.Errorf("expected\n%s\ngot\n%s", matrixToString(tt.expected), matrixToString(X))
+			}
+			E, _, _ := Exp(X)
+			if !matricesAlmostEqual(E, tt.matrix, 1e-10*oneNorm(tt.matrix)) {
+				t.Errorf("e^X != A\n%s", matrixToString(E))
+			}
+			if est > 1e-10 {
+				t.Errorf("error estimate %g is too large", est)
+			}
+		})
+	}
+}
+
+func TestLog_InverseOfExp(t *testing.T) {
+	for _, n := range []int{3, 10} {
+		t.Run(fmt.Sprintf("%dx%d", n, n), func(t *testing.T) {
+			// Eigenvalues of a matrix with ‖B‖₁ < π have imaginary part in (-π, π)
+			B := Scale(2/float64(n), randomFloatMatrix(n, n))
+			for i := range B {
+				B[i][i] -= 5
+			}
+			for oneNorm(B) >= math.Pi {
+				B = Scale(0.5, B)
+			}
+
+			A, _, _ := Exp(B)
+			X, est, err := Log(A)
+			if err != nil {
+				t.Fatalf("Log() error = %v", err)
+			}
+			if !matricesAlmostEqual(X, B, 1e-10) {
+				t.Errorf("Log(e^B) != B\n%s", matrixToString(X))
+			}
+			if est > 1e-10 {
+				t.Errorf("error estimate %g is too large", est)
+			}
+		})
+	}
+}
+
+func TestLog_Errors(t *testing.T) {
+	tests := []struct {
+		name   string
+		matrix Matrix[float64]
+		target error
+	}{
+		{"Empty", Matrix[float64]{}, ErrEmpty},
+		{"Non-square", Matrix[float64]{{1, 2, 3}, {4, 5, 6}}, ErrNotSquare},
+		{"Singular", Matrix[float64]{{1, 2}, {2, 4}}, ErrDomain},
+		{"Negative eigenvalue", Matrix[float64]{{-1, 0}, {0, 2}}, ErrDomain},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, _, err := Log(tt.matrix); !errors.Is(err, tt.target) {
+				t.Errorf("expected %v, got %v", tt.target, err)
+			}
+		})
+	}
+}
+
+func TestGaussLegendre(t *testing.T) {
+	// An m-point rule integrates polynomials of degree 2m-1 exactly
+	for m := 1; m <= 8; m++ {
+		nodes, weights := gaussLegendre(m)
+		for deg := range 2 * m {
+			sum := 0.0
+			for i, x := range nodes {
+				sum += weights[i] * math.Pow(x, float64(deg))
+			}
+			if want := 1 / float64(deg+1); math.Abs(sum-want) > 1e-14 {
+				t.Errorf("m = %d: ∫₀¹ x^%d dx = %g, expected %g", m, deg, sum, want)
+			}
+		}
+	}
+}
+
+func BenchmarkLog(b *testing.B) {
+	sizes := []int{10, 50, 100}
+
+	for _, size := range sizes {
+		b.Run(fmt.Sprintf("%dx%d", size, size), func(b *testing.B) {
+			A := randomShiftedMatrix(size, 10*float64(size))
+			b.ResetTimer()
+			for b.Loop() {
+				_, _, _ = Log(A)
+			}
+		})
+	}
+}
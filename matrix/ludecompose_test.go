@@ -1,8 +1,11 @@
 package matrix
 
 import (
+	"errors"
 	"math"
 	"testing"
+
+	"github.com/rickykimani/linalg/vectors"
 )
 
 func TestLUDecompose(t *testing.T) {
@@ -128,7 +131,7 @@ func TestLUDecompose(t *testing.T) {
 			}
 
 			if err != nil {
-				if tt.singular && err.Error() != "matrix is singular" {
+				if tt.singular && !errors.Is(err, ErrSingular) {
 					t.Fatalf("Expected singular matrix error, got: %v", err)
 				} else if !tt.singular {
 					t.Fatalf("LUDecompose() error = %v", err)
@@ -205,6 +208,131 @@ func TestLUDecompose(t *testing.T) {
 	}
 }
 
+func TestNewLU(t *testing.T) {
+	A := Matrix[int]{
+		{0, 1, 2},
+		{1, 2, 3},
+		{2, 3, 5},
+	}
+
+	f, err := NewLU(A)
+	if err != nil {
+		t.Fatalf("NewLU() error = %v", err)
+	}
+	if f.IsSingular() {
+		t.Fatal("expected non-singular factorization")
+	}
+
+	// P·A must equal L·U exactly as reported by the factorization
+	PA, _ := Multiply(f.P(), A)
+	LU, _ := Multiply(f.L(), f.U())
+	if !matricesAlmostEqual(PA, LU, 1e-12) {
+		t.Errorf("P·A != L·U\nP·A:\n%sL·U:\n%s", matrixToString(PA), matrixToString(LU))
+	}
+	if !matricesAlmostEqual(f.Reconstruct(), PA, 1e-12) {
+		t.Errorf("Reconstruct() != P·A\ngot:\n%s", matrixToString(f.Reconstruct()))
+	}
+
+	// Pivot rows must describe the same permutation as P
+	for i, r := range f.Pivot() {
+		if !rowsAlmostEqual(PA[i], toFloat64Matrix(A)[r], 0) {
+			t.Errorf("row %d of P·A is not row %d of A", i, r)
+		}
+	}
+
+	if det := f.Det(); math.Abs(det-(-1)) > 1e-12 {
+		t.Errorf("Det() = %v, want -1", det)
+	}
+
+	t.Run("solve vector", func(t *testing.T) {
+		want := vectors.Vector[float64]{1, -2, 3}
+		b, _ := MultiplyVector(A, want)
+		x, err := f.SolveVector(b)
+		if err != nil {
+			t.Fatalf("SolveVector() error = %v", err)
+		}
+		for i := range want {
+			if math.Abs(x[i]-want[i]) > 1e-12 {
+				t.Errorf("x[%d] = %v, want %v", i, x[i], want[i])
+			}
+		}
+
+		if _, err := f.SolveVector(vectors.Vector[float64]{1, 2}); err == nil {
+			t.Error("expected error for mismatched vector length")
+		}
+	})
+
+	t.Run("solve matrix", func(t *testing.T) {
+		B := Matrix[float64]{
+			{1, 0},
+			{0, 1},
+			{1, 1},
+		}
+		X, err := f.SolveMatrix(B)
+		if err != nil {
+			t.Fatalf("SolveMatrix() error = %v", err)
+		}
+		AX, _ := Multiply(A, X)
+		if !matricesAlmostEqual(AX, B, 1e-12) {
+			t.Errorf("A·X != B\ngot:\n%s", matrixToString(AX))
+		}
+	})
+
+	t.Run("inverse", func(t *testing.T) {
+		inv, err := f.Inverse()
+		if err != nil {
+			t.Fatalf("Inverse() error = %v", err)
+		}
+		product, _ := Multiply(A, inv)
+		if !matricesAlmostEqual(product, Identity(3), 1e-12) {
+			t.Errorf("A·A⁻¹ != I\ngot:\n%s", matrixToString(product))
+		}
+	})
+}
+
+func TestNewLU_Singular(t *testing.T) {
+	A := Matrix[int]{
+		{1, 2, 3},
+		{2, 4, 6},
+		{1, 0, 1},
+	}
+
+	f, err := NewLU(A)
+	if err != nil {
+		t.Fatalf("NewLU() error = %v", err)
+	}
+	if !f.IsSingular() {
+		t.Fatal("expected singular factorization")
+	}
+	if f.Det() != 0 {
+		t.Errorf("Det() = %v, want 0", f.Det())
+	}
+	if _, err := f.SolveVector(vectors.Vector[float64]{1, 2, 3}); err == nil {
+		t.Error("expected error solving a singular system")
+	}
+	if _, err := f.Inverse(); err == nil {
+		t.Error("expected error inverting a singular matrix")
+	}
+
+	// The factorization itself must still be exact
+	PA, _ := Multiply(f.P(), A)
+	if !matricesAlmostEqual(f.Reconstruct(), PA, 1e-12) {
+		t.Errorf("Reconstruct() != P·A for singular matrix")
+	}
+}
+
+func BenchmarkLUSolve100x100(b *testing.B) {
+	f, _ := NewLU(randomFloatMatrix(100, 100))
+	rhs := make(vectors.Vector[float64], 100)
+	for i := range rhs {
+		rhs[i] = float64(i)
+	}
+
+	for b.Loop() {
+		_, _ = f.SolveVector(rhs)
+	}
+}
+
 func BenchmarkLUDecompose100x100(b *testing.B) {
 	m := randomIntMatrix(100, 100)
 
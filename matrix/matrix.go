@@ -10,7 +10,10 @@ package matrix
 
 import (
 	"fmt"
+	"math"
 	"slices"
+
+	"github.com/rickykimani/linalg/internal/negligible"
 )
 
 // Matrix represents a mathematical matrix as a slice of slices.
@@ -62,17 +65,19 @@ func NewMatrix[T int | float64](data [][]T) (Matrix[float64], error) {
 
 // NewEmptyMatrix creates a new zero matrix of the specified dimensions.
 //
-// This function allocates a new matrix with all elements initialized to zero.
-// It's useful for creating matrices that will be populated later or for
-// initializing accumulator matrices in mathematical operations.
+// This function allocates a new matrix with all elements initialized to zero,
+// backed by a single contiguous slice. It's useful for creating matrices that
+// will be populated later or for initializing accumulator matrices in
+// mathematical operations.
 //
 // Parameters:
 //   - rows: The number of rows in the matrix
 //   - cols: The number of columns in the matrix
 //
 // Returns:
-//   - Matrix[float64]: A new zero matrix with the specified dimensions
-//   - error: An error if either dimension is negative
+//   - Matrix[float64]: A new zero matrix with the specified dimensions; rows
+//     empty rows when cols is zero
+//   - error: An error if either dimension is negative (ErrInvalidArgument)
 //
 // Example:
 //
@@ -80,20 +85,25 @@ func NewMatrix[T int | float64](data [][]T) (Matrix[float64], error) {
 //	// [[0, 0], [0, 0], [0, 0]]
 func NewEmptyMatrix(rows, cols int) (Matrix[float64], error) {
 	if rows < 0 || cols < 0 {
-		return nil, fmt.Errorf("matrix dimensions cannot be negative: got %d×%d", rows, cols)
+		return nil, fmt.Errorf("matrix dimensions cannot be negative: got %d×%d: %w", rows, cols, ErrInvalidArgument)
 	}
 
-	if rows == 0 || cols == 0 {
+	if rows == 0 {
 		return Matrix[float64]{}, nil // Return empty matrix for zero dimensions
 	}
-
-	result := make(Matrix[float64], rows)
-	for i := range rows {
-		result[i] = make([]float64, cols)
-		// Elements are automatically initialized to 0.0
+	if cols == 0 {
+		// Keep the row count, so that a product with no columns has rows×0 shape
+		result := make(Matrix[float64], rows)
+		for i := range result {
+			result[i] = []float64{}
+		}
+		return result, nil
 	}
 
-	return result, nil
+	// All rows share one contiguous allocation, as in a Dense matrix.
+	// Elements are automatically initialized to 0.0
+	d := &Dense{rows: rows, cols: cols, stride: cols, data: make([]float64, rows*cols)}
+	return d.Matrix(), nil
 }
 
 // Validate checks if all rows in the matrix have the same length.
@@ -125,6 +135,38 @@ func (m *Matrix[T]) isSquare() bool {
 	return len(*m) == len((*m)[0])
 }
 
+// symmetryTol is the default relative tolerance used when checking that a
+// matrix is symmetric: |a[i][j] - a[j][i]| may not exceed symmetryTol times
+// the largest absolute entry.
+const symmetryTol = 1e-10
+
+// isSymmetric returns true if the matrix is square and equal to its transpose
+// within the tolerance, relative to the largest absolute entry (default
+// symmetryTol).
+func (m *Matrix[T]) isSymmetric(tol []Tolerance) bool {
+	if !m.isSquare() {
+		return false
+	}
+
+	scale := 0.0
+	for _, row := range *m {
+		for _, v := range row {
+			scale = max(scale, math.Abs(float64(v)))
+		}
+	}
+
+	limit := negligible.Threshold(tol, symmetryTol, scale)
+	n := len(*m)
+	for i := range n {
+		for j := i + 1; j < n; j++ {
+			if math.Abs(float64((*m)[i][j])-float64((*m)[j][i])) > limit {
+				return false
+			}
+		}
+	}
+	return true
+}
+
 // cloneMatrix creates a deep copy of the input matrix.
 //
 // All rows and elements are copied, so modifications to the clone
@@ -137,6 +179,10 @@ func cloneMatrix[T int | float64](m Matrix[T]) Matrix[T] {
 	return clone
 }
 
+// machEps is the machine epsilon for float64, the gap between 1 and the next
+// representable number (2⁻⁵²).
+const machEps = 0x1p-52
+
 // abs returns the absolute value of a number.
 //
 // This is a generic function that works with both int and float64 types.
@@ -197,6 +243,12 @@ func (m *Matrix[T]) Cols() int {
 	return len((*m)[0])
 }
 
+// shape returns the rows and columns of the matrix, as reported by a
+// DimensionError.
+func (m *Matrix[T]) shape() [2]int {
+	return [2]int{m.Rows(), m.Cols()}
+}
+
 // Get retrieves the value at the specified row and column in the matrix.
 //
 // This method provides bounds-checked access for reading matrix elements.
@@ -209,7 +261,7 @@ func (m *Matrix[T]) Cols() int {
 //
 // Returns:
 //   - T: The value at the specified position
-//   - error: An error if either index is out of bounds
+//   - error: An error if either index is out of bounds (ErrInvalidArgument)
 //
 // Example:
 //
@@ -219,11 +271,11 @@ func (m *Matrix[T]) Get(row, col int) (T, error) {
 	var zero T
 
 	if row < 0 || row >= len(*m) {
-		return zero, fmt.Errorf("row index %d out of bounds for matrix with %d rows", row, len(*m))
+		return zero, fmt.Errorf("row index %d out of bounds for matrix with %d rows: %w", row, len(*m), ErrInvalidArgument)
 	}
 
 	if col < 0 || col >= len((*m)[row]) {
-		return zero, fmt.Errorf("column index %d out of bounds for row %d with %d columns", col, row, len((*m)[row]))
+		return zero, fmt.Errorf("column index %d out of bounds for row %d with %d columns: %w", col, row, len((*m)[row]), ErrInvalidArgument)
 	}
 
 	return (*m)[row][col], nil
@@ -241,7 +293,7 @@ func (m *Matrix[T]) Get(row, col int) (T, error) {
 //   - val: The new value to set at the specified position
 //
 // Returns:
-//   - error: An error if either index is out of bounds
+//   - error: An error if either index is out of bounds (ErrInvalidArgument)
 //
 // Example:
 //
@@ -249,11 +301,11 @@ func (m *Matrix[T]) Get(row, col int) (T, error) {
 //	err := mat.Set(1, 0, 5.0)  // mat becomes {{1.0, 2.0}, {5.0, 4.0}}
 func (m *Matrix[T]) Set(row, col int, val T) error {
 	if row < 0 || row >= len(*m) {
-		return fmt.Errorf("row index %d out of bounds for matrix with %d rows", row, len(*m))
+		return fmt.Errorf("row index %d out of bounds for matrix with %d rows: %w", row, len(*m), ErrInvalidArgument)
 	}
 
 	if col < 0 || col >= len((*m)[row]) {
-		return fmt.Errorf("column index %d out of bounds for row %d with %d columns", col, row, len((*m)[row]))
+		return fmt.Errorf("column index %d out of bounds for row %d with %d columns: %w", col, row, len((*m)[row]), ErrInvalidArgument)
 	}
 
 	(*m)[row][col] = val
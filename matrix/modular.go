@@ -0,0 +1,127 @@
+package matrix
+
+import (
+	"fmt"
+	"math/bits"
+)
+
+// MultiplyMod multiplies two integer matrices modulo mod.
+//
+// Parameters:
+//   - a: First matrix of type Matrix[int]
+//   - b: Second matrix of type Matrix[int]
+//   - mod: Positive modulus
+//
+// Returns:
+//   - Matrix[int]: The product a·b with every element reduced to [0, mod)
+//   - error: An error if either matrix is empty (ErrEmpty), the dimensions are
+//     incompatible (*DimensionError), or mod is not positive (ErrInvalidArgument)
+//
+// Negative elements are reduced to their non-negative residues first. Every
+// product and sum is computed in 128-bit precision before it is reduced, so
+// the result is exact for any modulus up to math.MaxInt and never overflows.
+//
+// Example:
+//
+//	A := Matrix[int]{{1, 1}, {1, 0}}
+//	P, _ := MultiplyMod(A, A, 3)  // Returns [[2, 1], [1, 1]]
+//
+// Time complexity: O(rows × cols × common), as for Multiply.
+func MultiplyMod(a, b Matrix[int], mod int) (Matrix[int], error) {
+	if err := checkProduct("MultiplyMod", a, b); err != nil {
+		return nil, err
+	}
+	if mod <= 0 {
+		return nil, fmt.Errorf("modulus must be positive: got %d: %w", mod, ErrInvalidArgument)
+	}
+	return multiplyMod(reduceMod(a, mod), reduceMod(b, mod), mod), nil
+}
+
+// PowMod raises a square integer matrix to a non-negative power modulo mod.
+//
+// Parameters:
+//   - m: A square matrix of type Matrix[int]
+//   - n: Non-negative power to which the matrix is raised
+//   - mod: Positive modulus
+//
+// Returns:
+//   - Matrix[int]: The matrix mⁿ with every element reduced to [0, mod)
+//   - error: An error if the matrix is invalid, empty (ErrEmpty) or not square
+//     (ErrNotSquare), or n is negative or mod is not positive (ErrInvalidArgument)
+//
+// This is the standard tool for linear recurrences and path counting: the
+// n-th Fibonacci number modulo p is element (0, 1) of [[1, 1], [1, 0]]ⁿ, and
+// the number of walks of length n from vertex i to vertex j in a graph is
+// element (i, j) of the n-th power of its adjacency matrix. Binary
+// exponentiation needs only O(log n) products, so n can be as large as
+// math.MaxInt.
+//
+// Example:
+//
+//	F := Matrix[int]{{1, 1}, {1, 0}}
+//	P, _ := PowMod(F, 90, 1_000_000_007)  // P[0][1] = F₉₀ mod 1 000 000 007
+//
+// Time complexity: O(log n × m³) where m is the matrix dimension.
+func PowMod(m Matrix[int], n, mod int) (Matrix[int], error) {
+	if err := checkPow(m, n); err != nil {
+		return nil, err
+	}
+	if mod <= 0 {
+		return nil, fmt.Errorf("modulus must be positive: got %d: %w", mod, ErrInvalidArgument)
+	}
+
+	result, _ := powSame(reduceMod(m, mod), n, func(a, b Matrix[int]) (Matrix[int], error) {
+		return multiplyMod(a, b, mod), nil
+	})
+	// The identity returned for n = 0 is only reduced when mod is 1
+	return reduceMod(result, mod), nil
+}
+
+// reduceMod returns a copy of m with every element reduced to [0, mod).
+func reduceMod(m Matrix[int], mod int) Matrix[int] {
+	result := make(Matrix[int], len(m))
+	for i := range m {
+		result[i] = make([]int, len(m[i]))
+		for j, v := range m[i] {
+			v %= mod
+			if v < 0 {
+				v += mod
+			}
+			result[i][j] = v
+		}
+	}
+	return result
+}
+
+// multiplyMod computes a·b modulo mod for matrices whose elements already lie
+// in [0, mod).
+func multiplyMod(a, b Matrix[int], mod int) Matrix[int] {
+	rows, cols := len(a), len(b[0])
+	result := make(Matrix[int], rows)
+	for i := range rows {
+		result[i] = make([]int, cols)
+		for k, aik := range a[i] {
+			if aik == 0 {
+				continue
+			}
+			for j, bkj := range b[k] {
+				result[i][j] = addMod(result[i][j], mulMod(aik, bkj, mod), mod)
+			}
+		}
+	}
+	return result
+}
+
+// mulMod returns x·y mod m for x, y in [0, m), using a 128-bit product.
+func mulMod(x, y, m int) int {
+	hi, lo := bits.Mul64(uint64(x), uint64(y))
+	return int(bits.Rem64(hi, lo, uint64(m)))
+}
+
+// addMod returns x + y mod m for x, y in [0, m) without overflowing.
+func addMod(x, y, m int) int {
+	if x >= m-y {
+		return x - (m - y)
+	}
+	return x + y
+}
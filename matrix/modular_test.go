@@ -0,0 +1,157 @@
+package matrix
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestMultiplyMod(t *testing.T) {
+	tests := []struct {
+		name     string
+		a, b     Matrix[int]
+		mod      int
+		expected Matrix[int]
+	}{
+		{
+			name:     "Small modulus",
+			a:        Matrix[int]{{1, 1}, {1, 0}},
+			b:        Matrix[int]{{1, 1}, {1, 0}},
+			mod:      3,
+			expected: Matrix[int]{{2, 1}, {1, 1}},
+		},
+		{
+			name:     "Negative elements",
+			a:        Matrix[int]{{-1, 2}},
+			b:        Matrix[int]{{3}, {-4}},
+			mod:      7,
+			expected: Matrix[int]{{(-3 - 8 + 14) % 7}},
+		},
+		{
+			name:     "Modulus one",
+			a:        Matrix[int]{{5, 6}},
+			b:        Matrix[int]{{7}, {8}},
+			mod:      1,
+			expected: Matrix[int]{{0}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := MultiplyMod(tt.a, tt.b, tt.mod)
+			if err != nil {
+				t.Fatalf("MultiplyMod() error = %v", err)
+			}
+			if !intMatricesEqual(got, tt.expected) {
+				t.Errorf("MultiplyMod() = %v, expected %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestMultiplyMod_LargeModulus(t *testing.T) {
+	// Products of elements near MaxInt need 128 bits before reduction
+	mod := math.MaxInt - 24 // Largest prime below 2⁶³
+	a := Matrix[int]{{math.MaxInt - 30, -5}, {mod - 1, 1 << 62}}
+	b := Matrix[int]{{math.MaxInt, 3}, {mod - 2, math.MinInt}}
+
+	got, err := MultiplyMod(a, b, mod)
+	if err != nil {
+		t.Fatalf("MultiplyMod() error = %v", err)
+	}
+
+	m := big.NewInt(int64(mod))
+	for i := range 2 {
+		for j := range 2 {
+			s := new(big.Int)
+			for k := range 2 {
+				p := new(big.Int).Mul(big.NewInt(int64(a[i][k])), big.NewInt(int64(b[k][j])))
+				s.Add(s, p)
+			}
+			s.Mod(s, m)
+			if int64(got[i][j]) != s.Int64() {
+				t.Errorf("MultiplyMod()[%d][%d] = %d, expected %s", i, j, got[i][j], s)
+			}
+		}
+	}
+}
+
+func TestPowMod(t *testing.T) {
+	const p = 1_000_000_007
+	fib := Matrix[int]{{1, 1}, {1, 0}}
+
+	// Fibonacci numbers modulo p, far beyond the range of int
+	f0, f1 := 0, 1
+	for n := 1; n <= 1000; n++ {
+		f0, f1 = f1, (f0+f1)%p
+		if n%97 != 0 && n != 1000 {
+			continue
+		}
+		got, err := PowMod(fib, n, p)
+		if err != nil {
+			t.Fatalf("PowMod(%d) error = %v", n, err)
+		}
+		if got[0][1] != f0 {
+			t.Errorf("PowMod(%d)[0][1] = %d, expected F%d mod p = %d", n, got[0][1], n, f0)
+		}
+	}
+
+	// Pisano period: Fⁿ mod p repeats with period 2p+2 for p = 1e9+7
+	got, _ := PowMod(fib, 2*p+2, p)
+	if !intMatricesEqual(got, Matrix[int]{{1, 0}, {0, 1}}) {
+		t.Errorf("PowMod(F, 2p+2) = %v, expected the identity", got)
+	}
+
+	// Walks of length 4 in the triangle graph agree with PowSame
+	triangle := Matrix[int]{{0, 1, 1}, {1, 0, 1}, {1, 1, 0}}
+	walks, _ := PowSame(triangle, 4)
+	if got, _ := PowMod(triangle, 4, 1000); !intMatricesEqual(got, walks) {
+		t.Errorf("PowMod(triangle, 4) = %v, expected %v", got, walks)
+	}
+
+	if got, _ := PowMod(fib, 0, 1); !intMatricesEqual(got, Matrix[int]{{0, 0}, {0, 0}}) {
+		t.Errorf("PowMod(F, 0, 1) = %v, expected zeros", got)
+	}
+	if got, _ := PowMod(Matrix[int]{{-1}}, 3, 5); got[0][0] != 4 {
+		t.Errorf("PowMod([[-1]], 3, 5) = %v, expected [[4]]", got)
+	}
+}
+
+func TestModular_Errors(t *testing.T) {
+	a := Matrix[int]{{1, 2}, {3, 4}}
+
+	if _, err := MultiplyMod(a, a, 0); !errors.Is(err, ErrInvalidArgument) {
+		t.Errorf("MultiplyMod: expected ErrInvalidArgument for a zero modulus, got %v", err)
+	}
+	if _, err := MultiplyMod(a, Matrix[int]{{1, 2}}, 5); !errors.Is(err, ErrDimensionMismatch) {
+		t.Errorf("MultiplyMod: expected ErrDimensionMismatch, got %v", err)
+	}
+	if _, err := PowMod(a, 2, -3); !errors.Is(err, ErrInvalidArgument) {
+		t.Errorf("PowMod: expected ErrInvalidArgument for a negative modulus, got %v", err)
+	}
+	if _, err := PowMod(a, -1, 5); !errors.Is(err, ErrInvalidArgument) {
+		t.Errorf("PowMod: expected ErrInvalidArgument for a negative power, got %v", err)
+	}
+	if _, err := PowMod(Matrix[int]{}, 2, 5); !errors.Is(err, ErrEmpty) {
+		t.Errorf("PowMod: expected ErrEmpty, got %v", err)
+	}
+	if _, err := PowMod(Matrix[int]{{1, 2}}, 2, 5); !errors.Is(err, ErrNotSquare) {
+		t.Errorf("PowMod: expected ErrNotSquare, got %v", err)
+	}
+}
+
+func BenchmarkPowMod(b *testing.B) {
+	sizes := []int{10, 50}
+
+	for _, size := range sizes {
+		b.Run(fmt.Sprintf("%dx%d", size, size), func(b *testing.B) {
+			A := randomIntMatrix(size, size)
+			b.ResetTimer()
+			for b.Loop() {
+				_, _ = PowMod(A, 1_000_000, 1_000_000_007)
+			}
+		})
+	}
+}
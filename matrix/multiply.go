@@ -1,7 +1,6 @@
 package matrix
 
 import (
-	"errors"
 	"fmt"
 
 	"github.com/rickykimani/linalg/vectors"
@@ -20,7 +19,8 @@ import (
 // Returns:
 //   - Matrix[float64]: The resulting matrix, always with float64 elements to accommodate
 //     mixed type operations
-//   - error: An error if either matrix is empty or if the dimensions are incompatible
+//   - error: An error if either matrix is empty (ErrEmpty) or if the dimensions are
+//     incompatible (*DimensionError, matching ErrDimensionMismatch)
 //
 // The time complexity is O(n³) for square matrices of size n×n, or more generally
 // O(rows × cols × common) where rows and cols are the dimensions of the result matrix
@@ -36,30 +36,80 @@ func Multiply[T, E int | float64](a Matrix[T], b Matrix[E]) (Matrix[float64], er
 
 	// Handle empty matrices
 	if len(a) == 0 || len(b) == 0 {
-		return nil, errors.New("empty matrix")
+		return nil, ErrEmpty
 	}
 
 	// Check dimension compatibility
 	if len(a[0]) != len(b) {
-		return nil, errors.New("incompatible dimensions")
+		return nil, dimensionError("Multiply", a.shape(), b.shape())
 	}
 
 	// Perform multiplication
 	rows := len(a)
 	cols := len(b[0])
-	inner := len(b)
 
-	result := make(Matrix[float64], rows)
-	for i := range rows {
-		result[i] = make([]float64, cols)
-		for j := range cols {
-			for k := range inner {
-				result[i][j] += float64(a[i][k]) * float64(b[k][j])
+	result, _ := NewEmptyMatrix(rows, cols)
+	multiplyInto(result, a, b)
+
+	return result, nil
+}
+
+// MultiplyTo stores the matrix product a·b in dst.
+//
+// Parameters:
+//   - dst: Destination matrix with as many rows as a and as many columns as b,
+//     typically a view
+//   - a: First matrix of type Matrix[T] where T is int or float64
+//   - b: Second matrix of type Matrix[E] where E is int or float64
+//
+// Returns:
+//   - error: An error if any matrix is invalid or empty, or the dimensions are incompatible
+//
+// No memory is allocated. dst must not share storage with a or b, since it is
+// overwritten while they are still being read.
+//
+// Time complexity: O(rows × cols × common), as for Multiply.
+func MultiplyTo[T, E int | float64](dst Matrix[float64], a Matrix[T], b Matrix[E]) error {
+	if err := a.Validate(); err != nil {
+		return fmt.Errorf("first matrix: %w", err)
+	}
+	if err := b.Validate(); err != nil {
+		return fmt.Errorf("second matrix: %w", err)
+	}
+	if err := dst.Validate(); err != nil {
+		return fmt.Errorf("destination: %w", err)
+	}
+
+	if len(a) == 0 || len(b) == 0 || len(dst) == 0 {
+		return ErrEmpty
+	}
+
+	if len(a[0]) != len(b) || len(dst) != len(a) || len(dst[0]) != len(b[0]) {
+		return dimensionError("MultiplyTo", a.shape(), b.shape(), dst.shape())
+	}
+
+	for _, row := range dst {
+		clear(row)
+	}
+	multiplyInto(dst, a, b)
+	return nil
+}
+
+// multiplyInto adds the product a·b to dst.
+//
+// The i-k-j loop order streams through rows of b and dst, which is far more
+// cache friendly than walking down the columns of b. Every element still
+// accumulates its products in order of increasing k.
+func multiplyInto[T, E int | float64](dst Matrix[float64], a Matrix[T], b Matrix[E]) {
+	for i := range dst {
+		di := dst[i]
+		for k := range b {
+			aik := float64(a[i][k])
+			for j, bkj := range b[k] {
+				di[j] += aik * float64(bkj)
 			}
 		}
 	}
-
-	return result, nil
 }
 
 // MultiplyVector performs matrix-vector multiplication (M × v).
@@ -89,15 +139,15 @@ func MultiplyVector[T, E int | float64](m Matrix[T], v vectors.Vector[E]) (vecto
 
 	// Handle empty inputs
 	if len(m) == 0 {
-		return nil, errors.New("empty matrix")
+		return nil, ErrEmpty
 	}
 	if len(v) == 0 {
-		return nil, errors.New("empty vector")
+		return nil, vectors.ErrEmpty
 	}
 
 	// Check dimension compatibility
 	if len(m[0]) != len(v) {
-		return nil, fmt.Errorf("incompatible dimensions: matrix has %d columns, vector has %d elements", len(m[0]), len(v))
+		return nil, dimensionError("MultiplyVector", m.shape(), [2]int{len(v), 1})
 	}
 
 	// Perform matrix-vector multiplication
@@ -140,15 +190,15 @@ func VectorMultiply[T, E int | float64](v vectors.Vector[T], m Matrix[E]) (vecto
 
 	// Handle empty inputs
 	if len(v) == 0 {
-		return nil, errors.New("empty vector")
+		return nil, vectors.ErrEmpty
 	}
 	if len(m) == 0 {
-		return nil, errors.New("empty matrix")
+		return nil, ErrEmpty
 	}
 
 	// Check dimension compatibility
 	if len(v) != len(m) {
-		return nil, fmt.Errorf("incompatible dimensions: vector has %d elements, matrix has %d rows", len(v), len(m))
+		return nil, dimensionError("VectorMultiply", [2]int{1, len(v)}, m.shape())
 	}
 
 	// Perform vector-matrix multiplication
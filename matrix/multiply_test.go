@@ -1,6 +1,7 @@
 package matrix
 
 import (
+	"errors"
 	"reflect"
 	"testing"
 )
@@ -118,8 +119,8 @@ func TestMultiply(t *testing.T) {
 		if err == nil {
 			t.Error("expected empty matrix error")
 		}
-		if err.Error() != "empty matrix" {
-			t.Errorf("expected 'empty matrix' error, got: %v", err)
+		if !errors.Is(err, ErrEmpty) {
+			t.Errorf("expected ErrEmpty, got: %v", err)
 		}
 	})
 
@@ -133,8 +134,21 @@ func TestMultiply(t *testing.T) {
 		if err == nil {
 			t.Error("expected empty matrix error")
 		}
-		if err.Error() != "empty matrix" {
-			t.Errorf("expected 'empty matrix' error, got: %v", err)
+		if !errors.Is(err, ErrEmpty) {
+			t.Errorf("expected ErrEmpty, got: %v", err)
+		}
+	})
+
+	t.Run("result with zero columns", func(t *testing.T) {
+		a := Matrix[int]{{1}, {2}, {3}}
+		b := Matrix[int]{{}}
+		result, err := Multiply(a, b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		expected := Matrix[float64]{{}, {}, {}}
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("expected 3×0 matrix %v, got %v", expected, result)
 		}
 	})
 
@@ -0,0 +1,250 @@
+package matrix
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/rickykimani/linalg/vectors"
+)
+
+// hilbert returns the n×n Hilbert matrix H[i][j] = 1/(i+j+1), a classic
+// example of severe ill-conditioning.
+func hilbert(n int) Matrix[float64] {
+	h, _ := NewEmptyMatrix(n, n)
+	for i := range n {
+		for j := range n {
+			h[i][j] = 1 / float64(i+j+1)
+		}
+	}
+	return h
+}
+
+func TestNorm(t *testing.T) {
+	A := Matrix[int]{{1, -2}, {3, 4}}
+	rect := Matrix[float64]{{3, 0, 0}, {0, -4, 0}}
+
+	tests := []struct {
+		name     string
+		matrix   Matrix[float64]
+		kind     NormType
+		expected float64
+	}{
+		{"1-norm", toFloat64Matrix(A), Norm1, 6},
+		{"inf-norm", toFloat64Matrix(A), NormInf, 7},
+		{"Frobenius", toFloat64Matrix(A), NormFrobenius, math.Sqrt(30)},
+		{"max", toFloat64Matrix(A), NormMax, 4},
+		{"2-norm", toFloat64Matrix(A), Norm2, math.Sqrt(15 + math.Sqrt(125))},
+		{"rectangular 1-norm", rect, Norm1, 4},
+		{"rectangular inf-norm", rect, NormInf, 4},
+		{"rectangular 2-norm", rect, Norm2, 4},
+		{"zero matrix", Matrix[float64]{{0, 0}, {0, 0}}, NormFrobenius, 0},
+		{"Frobenius without overflow", Matrix[float64]{{1e200, 1e200}}, NormFrobenius, math.Sqrt2 * 1e200},
+		{"Frobenius without underflow", Matrix[float64]{{3e-200}, {4e-200}}, NormFrobenius, 5e-200},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Norm(tt.matrix, tt.kind)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if math.Abs(got-tt.expected) > 1e-12*max(1, tt.expected) {
+				t.Errorf("expected %g, got %g", tt.expected, got)
+			}
+		})
+	}
+
+	// Int matrices take the same path
+	if got, _ := Norm(A, Norm1); got != 6 {
+		t.Errorf("int matrix: expected 6, got %g", got)
+	}
+}
+
+func TestNorm_Inequalities(t *testing.T) {
+	// ‖A‖₂ ≤ ‖A‖_F ≤ √rank·‖A‖₂ and ‖A‖₂ ≤ √(‖A‖₁·‖A‖∞)
+	for range 20 {
+		A := randomFloatMatrix(5, 7)
+		n1, _ := Norm(A, Norm1)
+		nInf, _ := Norm(A, NormInf)
+		nF, _ := Norm(A, NormFrobenius)
+		n2, _ := Norm(A, Norm2)
+		nMax, _ := Norm(A, NormMax)
+
+		if n2 > nF*(1+1e-12) || nF > math.Sqrt(5)*n2*(1+1e-12) {
+			t.Errorf("2-norm %g and Frobenius norm %g violate ‖A‖₂ ≤ ‖A‖_F ≤ √5·‖A‖₂", n2, nF)
+		}
+		if n2 > math.Sqrt(n1*nInf)*(1+1e-12) {
+			t.Errorf("2-norm %g exceeds √(‖A‖₁·‖A‖∞) = %g", n2, math.Sqrt(n1*nInf))
+		}
+		if nMax > n2*(1+1e-12) {
+			t.Errorf("max norm %g exceeds 2-norm %g", nMax, n2)
+		}
+	}
+}
+
+func TestNorm_Errors(t *testing.T) {
+	tests := []struct {
+		name   string
+		matrix Matrix[float64]
+		kind   NormType
+	}{
+		{"empty", Matrix[float64]{}, Norm1},
+		{"empty rows", Matrix[float64]{{}}, NormMax},
+		{"jagged", Matrix[float64]{{1, 2}, {3}}, NormFrobenius},
+		{"unknown kind", Matrix[float64]{{1}}, NormType(42)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Norm(tt.matrix, tt.kind); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
+
+func TestNormType_String(t *testing.T) {
+	if s := NormFrobenius.String(); s != "Frobenius norm" {
+		t.Errorf("expected %q, got %q", "Frobenius norm", s)
+	}
+	if s := NormType(9).String(); s != "NormType(9)" {
+		t.Errorf("expected %q, got %q", "NormType(9)", s)
+	}
+}
+
+func TestCond(t *testing.T) {
+	tests := []struct {
+		name     string
+		matrix   Matrix[float64]
+		expected float64
+	}{
+		{"identity", Identity(4), 1},
+		{"diagonal", Matrix[float64]{{1, 0}, {0, 10}}, 10},
+		{"orthogonal", Matrix[float64]{{0, 1}, {-1, 0}}, 1},
+		{"rectangular", Matrix[float64]{{2, 0}, {0, 1}, {0, 0}}, 2},
+		{"singular", Matrix[float64]{{1, 2}, {2, 4}}, math.Inf(1)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Cond(tt.matrix)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if math.IsInf(tt.expected, 1) {
+				if got < 1e15 {
+					t.Errorf("expected a huge condition number, got %g", got)
+				}
+				return
+			}
+			if math.Abs(got-tt.expected) > 1e-12*tt.expected {
+				t.Errorf("expected %g, got %g", tt.expected, got)
+			}
+		})
+	}
+
+	if _, err := Cond(Matrix[int]{}); err == nil {
+		t.Error("expected error for empty matrix")
+	}
+}
+
+func TestLU_CondEst(t *testing.T) {
+	// The estimate is a lower bound on κ₁ that is rarely off by more than 3
+	check := func(t *testing.T, A Matrix[float64]) {
+		t.Helper()
+		f, err := NewLU(A)
+		if err != nil {
+			t.Fatal(err)
+		}
+		inv, err := f.Inverse()
+		if err != nil {
+			t.Fatal(err)
+		}
+		n1, _ := Norm(A, Norm1)
+		invN1, _ := Norm(inv, Norm1)
+		exact := n1 * invN1
+
+		est := f.CondEst()
+		if est > exact*(1+1e-8) || est < exact/3 {
+			t.Errorf("estimate %g, exact κ₁ %g", est, exact)
+		}
+	}
+
+	t.Run("random", func(t *testing.T) {
+		// A fixed seed keeps the matrices, and so the estimates, reproducible
+		rng := rand.New(rand.NewSource(1))
+		for _, n := range []int{1, 2, 5, 20, 50} {
+			A := make(Matrix[float64], n)
+			for i := range A {
+				A[i] = make([]float64, n)
+				for j := range A[i] {
+					A[i][j] = rng.Float64() * 10
+				}
+			}
+			check(t, A)
+		}
+	})
+	t.Run("Hilbert", func(t *testing.T) {
+		for _, n := range []int{3, 6, 9} {
+			check(t, hilbert(n))
+		}
+	})
+	t.Run("triangular with large off-diagonal", func(t *testing.T) {
+		check(t, Matrix[float64]{{1, 1e6, 0}, {0, 1, 1e6}, {0, 0, 1}})
+	})
+
+	t.Run("singular", func(t *testing.T) {
+		f, _ := NewLU(Matrix[int]{{1, 2}, {2, 4}})
+		if est := f.CondEst(); !math.IsInf(est, 1) {
+			t.Errorf("expected +Inf, got %g", est)
+		}
+	})
+}
+
+func TestIllConditioned_Detection(t *testing.T) {
+	// Every pivot of this matrix is 1, so no pivot check can flag it, but
+	// κ₁ ≈ 10³⁶
+	A := Matrix[float64]{{1, 1e9, 0, 0}, {0, 1, 1e9, 0}, {0, 0, 1, 1e9}, {0, 0, 0, 1}}
+	b := vectors.Vector[float64]{1, 1, 1, 1}
+
+	_, err := Solve(A, b)
+	var condErr *ConditionError
+	if !errors.As(err, &condErr) {
+		t.Fatalf("Solve: expected *ConditionError, got %v", err)
+	}
+	if condErr.Cond < 1e30 {
+		t.Errorf("Solve: condition estimate %g, expected about 1e36", condErr.Cond)
+	}
+
+	if _, err := Inverse(A); !errors.Is(err, ErrIllConditioned) {
+		t.Errorf("Inverse: expected ErrIllConditioned, got %v", err)
+	}
+	if _, err := Inverse(hilbert(14)); !errors.Is(err, ErrIllConditioned) && !errors.Is(err, ErrSingular) {
+		t.Errorf("Inverse of Hilbert(14): expected an ill-conditioning error, got %v", err)
+	}
+
+	// Moderately ill-conditioned systems are still solved
+	if _, err := Inverse(hilbert(8)); err != nil {
+		t.Errorf("Inverse of Hilbert(8): unexpected error %v", err)
+	}
+	if _, err := Solve(hilbert(8), vectors.Vector[float64]{1, 1, 1, 1, 1, 1, 1, 1}); err != nil {
+		t.Errorf("Solve with Hilbert(8): unexpected error %v", err)
+	}
+}
+
+func BenchmarkNorm(b *testing.B) {
+	A := randomFloatMatrix(200, 200)
+	b.ResetTimer()
+	for b.Loop() {
+		Norm(A, NormFrobenius)
+	}
+}
+
+func BenchmarkLU_CondEst(b *testing.B) {
+	f, _ := NewLU(randomFloatMatrix(200, 200))
+	b.ResetTimer()
+	for b.Loop() {
+		f.CondEst()
+	}
+}
@@ -1,9 +1,6 @@
 package matrix
 
-import (
-	"errors"
-	"fmt"
-)
+import "fmt"
 
 // Pow raises a square matrix to the specified integer power.
 //
@@ -28,11 +25,11 @@ func Pow[T int | float64](m Matrix[T], n int) (Matrix[float64], error) {
 	}
 
 	if len(m) == 0 {
-		return nil, errors.New("empty matrix")
+		return nil, ErrEmpty
 	}
 
 	if !m.isSquare() {
-		return nil, errors.New("matrix must be square")
+		return nil, ErrNotSquare
 	}
 
 	size := len(m)
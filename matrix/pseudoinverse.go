@@ -0,0 +1,147 @@
+package matrix
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/rickykimani/linalg/internal/negligible"
+	"github.com/rickykimani/linalg/vectors"
+)
+
+// LeastSquaresResult holds the outcome of LeastSquares.
+type LeastSquaresResult struct {
+	X        vectors.Vector[float64] // Minimum-norm solution x
+	Residual float64                 // Residual norm ‖A·x - b‖₂
+	Rank     int                     // Effective rank of A used to compute x
+}
+
+// PseudoInverse computes the Moore–Penrose pseudoinverse A⁺ of a rectangular matrix.
+//
+// Parameters:
+//   - m: Input matrix of type Matrix[T] where T is int or float64, of any shape m×n
+//   - tol: Optional tolerance; a singular value no larger than it, relative to
+//     the largest singular value σ₁, counts as zero (default max(m, n)·ε)
+//
+// Returns:
+//   - Matrix[float64]: The n×m pseudoinverse A⁺
+//   - error: Returns error if the matrix is empty or non-rectangular, or the SVD
+//     fails to converge
+//
+// With A = U·Σ·Vᵀ, the pseudoinverse is A⁺ = V·Σ⁺·Uᵀ, where Σ⁺ inverts the
+// singular values above the tolerance and zeroes the rest. It coincides with
+// Inverse for nonsingular square matrices, but also exists for singular and
+// rectangular ones: A⁺·b is the minimum-norm least squares solution of A·x = b.
+//
+// Example:
+//
+//	A := Matrix[int]{{1, 2}, {2, 4}}
+//	P, _ := PseudoInverse(A)  // Returns [[0.04, 0.08], [0.08, 0.16]]
+func PseudoInverse[T int | float64](m Matrix[T], tol ...Tolerance) (Matrix[float64], error) {
+	U, S, Vt, err := SVD(m, SVDThin)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, cols := len(m), len(m[0])
+	r := svdRank(S, negligible.Threshold(tol, epsTol(max(rows, cols)), S[0]))
+
+	// A⁺[i][j] = sum over the first r singular triplets of V[i][k]·U[j][k]/σ_k
+	result, _ := NewEmptyMatrix(cols, rows)
+	for k := range r {
+		inv := 1 / S[k]
+		for i := range cols {
+			vik := Vt[k][i] * inv
+			if vik == 0 {
+				continue
+			}
+			for j := range rows {
+				result[i][j] += vik * U[j][k]
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// LeastSquares computes the minimum-norm least squares solution of A·x = b.
+//
+// Parameters:
+//   - a: Coefficient matrix of type Matrix[T] where T is int or float64, of any shape m×n
+//   - b: Right-hand side vector of type Vector[E] where E is int or float64, of length m
+//   - tol: Optional tolerance for the rank, as for PseudoInverse
+//
+// Returns:
+//   - LeastSquaresResult: The solution x, the residual norm ‖A·x - b‖₂ and the
+//     effective rank of A
+//   - error: Returns error if the inputs are invalid or empty, the dimensions do not
+//     match, or the SVD fails to converge
+//
+// Among all vectors minimizing ‖A·x - b‖₂, the one with the smallest ‖x‖₂ is
+// returned, so the result is well defined even when A is rank deficient. The
+// solution is computed from the SVD, x = A⁺·b, with singular values at or below
+// the tolerance treated as zero.
+//
+// Unlike Solve, which reports rank-deficient systems as errors, LeastSquares
+// always succeeds on valid input and reports the rank it used instead.
+func LeastSquares[T, E int | float64](a Matrix[T], b vectors.Vector[E], tol ...Tolerance) (LeastSquaresResult, error) {
+	// Validate matrix structure
+	if err := a.Validate(); err != nil {
+		return LeastSquaresResult{}, fmt.Errorf("matrix: %w", err)
+	}
+
+	// Handle empty inputs
+	if len(a) == 0 || len(a[0]) == 0 {
+		return LeastSquaresResult{}, ErrEmpty
+	}
+	if len(b) == 0 {
+		return LeastSquaresResult{}, vectors.ErrEmpty
+	}
+
+	// Check dimension compatibility
+	if len(a) != len(b) {
+		return LeastSquaresResult{}, dimensionError("LeastSquares", a.shape(), [2]int{len(b), 1})
+	}
+
+	U, S, Vt, err := SVD(a, SVDThin)
+	if err != nil {
+		return LeastSquaresResult{}, err
+	}
+
+	rows, cols := len(a), len(a[0])
+	r := svdRank(S, negligible.Threshold(tol, epsTol(max(rows, cols)), S[0]))
+
+	// x = sum over the first r singular triplets of (uₖᵀ·b / σₖ)·vₖ
+	x := make(vectors.Vector[float64], cols)
+	for k := range r {
+		coef := 0.0
+		for i := range rows {
+			coef += U[i][k] * float64(b[i])
+		}
+		coef /= S[k]
+		for i := range cols {
+			x[i] += coef * Vt[k][i]
+		}
+	}
+
+	// Residual norm ‖A·x - b‖₂
+	res := 0.0
+	for i := range rows {
+		ri := -float64(b[i])
+		for j := range cols {
+			ri += float64(a[i][j]) * x[j]
+		}
+		res = math.Hypot(res, ri)
+	}
+
+	return LeastSquaresResult{X: x, Residual: res, Rank: r}, nil
+}
+
+// svdRank counts the singular values, sorted in non-increasing order, that
+// are strictly greater than tol.
+func svdRank(s []float64, tol float64) int {
+	r := 0
+	for r < len(s) && s[r] > tol {
+		r++
+	}
+	return r
+}
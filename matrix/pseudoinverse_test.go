@@ -0,0 +1,168 @@
+package matrix
+
+import (
+	"math"
+	"testing"
+
+	"github.com/rickykimani/linalg/vectors"
+)
+
+func TestPseudoInverse(t *testing.T) {
+	t.Run("nonsingular square matches inverse", func(t *testing.T) {
+		A := Matrix[int]{
+			{3, 0, 2},
+			{2, 0, -2},
+			{0, 1, 1},
+		}
+		P, err := PseudoInverse(A)
+		if err != nil {
+			t.Fatalf("PseudoInverse() error = %v", err)
+		}
+		inv, _ := Inverse(A)
+		if !matricesAlmostEqual(P, inv, 1e-12) {
+			t.Errorf("PseudoInverse() = %v, want %v", P, inv)
+		}
+	})
+
+	t.Run("rank one", func(t *testing.T) {
+		A := Matrix[int]{
+			{1, 2},
+			{2, 4},
+		}
+		P, err := PseudoInverse(A)
+		if err != nil {
+			t.Fatalf("PseudoInverse() error = %v", err)
+		}
+		want := Matrix[float64]{
+			{0.04, 0.08},
+			{0.08, 0.16},
+		}
+		if !matricesAlmostEqual(P, want, 1e-12) {
+			t.Errorf("PseudoInverse() = %v, want %v", P, want)
+		}
+	})
+
+	// The four Penrose conditions characterize A⁺ for any shape
+	for _, A := range []Matrix[float64]{
+		randomFloatMatrix(5, 3),
+		randomFloatMatrix(2, 6),
+		{{1, 2, 3}, {2, 4, 6}, {1, 1, 1}, {0, 0, 0}},
+	} {
+		P, err := PseudoInverse(A)
+		if err != nil {
+			t.Fatalf("PseudoInverse() error = %v", err)
+		}
+		if P.Rows() != A.Cols() || P.Cols() != A.Rows() {
+			t.Fatalf("PseudoInverse() is %d×%d, want %d×%d", P.Rows(), P.Cols(), A.Cols(), A.Rows())
+		}
+
+		AP, _ := Multiply(A, P)
+		PA, _ := Multiply(P, A)
+		APA, _ := Multiply(AP, A)
+		PAP, _ := Multiply(PA, P)
+		if !matricesAlmostEqual(APA, A, 1e-10) {
+			t.Errorf("A·A⁺·A != A")
+		}
+		if !matricesAlmostEqual(PAP, P, 1e-10) {
+			t.Errorf("A⁺·A·A⁺ != A⁺")
+		}
+		if !matricesAlmostEqual(AP, Transpose(AP), 1e-10) {
+			t.Errorf("A·A⁺ is not symmetric")
+		}
+		if !matricesAlmostEqual(PA, Transpose(PA), 1e-10) {
+			t.Errorf("A⁺·A is not symmetric")
+		}
+	}
+
+	t.Run("explicit tolerance drops small singular values", func(t *testing.T) {
+		A := Matrix[float64]{
+			{1, 0},
+			{0, 1e-9},
+		}
+		P, _ := PseudoInverse(A, Tolerance{Abs: 1e-6})
+		want := Matrix[float64]{
+			{1, 0},
+			{0, 0},
+		}
+		if !matricesAlmostEqual(P, want, 1e-12) {
+			t.Errorf("PseudoInverse() = %v, want %v", P, want)
+		}
+	})
+
+	if _, err := PseudoInverse(Matrix[int]{}); err == nil {
+		t.Error("expected error for empty matrix")
+	}
+}
+
+func TestLeastSquares(t *testing.T) {
+	t.Run("full rank overdetermined", func(t *testing.T) {
+		A := Matrix[int]{
+			{1, 0},
+			{1, 1},
+			{1, 2},
+		}
+		got, err := LeastSquares(A, vectors.Vector[int]{1, 2, 2})
+		if err != nil {
+			t.Fatalf("LeastSquares() error = %v", err)
+		}
+		want := vectors.Vector[float64]{7.0 / 6, 0.5}
+		for i := range want {
+			if math.Abs(got.X[i]-want[i]) > 1e-12 {
+				t.Errorf("x[%d] = %v, want %v", i, got.X[i], want[i])
+			}
+		}
+		if got.Rank != 2 {
+			t.Errorf("Rank = %d, want 2", got.Rank)
+		}
+		if math.Abs(got.Residual-math.Sqrt(1.0/6)) > 1e-12 {
+			t.Errorf("Residual = %v, want %v", got.Residual, math.Sqrt(1.0/6))
+		}
+	})
+
+	t.Run("rank deficient gives minimum norm", func(t *testing.T) {
+		// Columns are identical, so every x with x₀ + x₁ = 1 fits exactly
+		A := Matrix[int]{
+			{1, 1},
+			{2, 2},
+			{3, 3},
+		}
+		got, err := LeastSquares(A, vectors.Vector[int]{1, 2, 3})
+		if err != nil {
+			t.Fatalf("LeastSquares() error = %v", err)
+		}
+		if got.Rank != 1 {
+			t.Errorf("Rank = %d, want 1", got.Rank)
+		}
+		if math.Abs(got.X[0]-0.5) > 1e-12 || math.Abs(got.X[1]-0.5) > 1e-12 {
+			t.Errorf("X = %v, want [0.5 0.5]", got.X)
+		}
+		if got.Residual > 1e-12 {
+			t.Errorf("Residual = %v, want 0", got.Residual)
+		}
+	})
+
+	t.Run("underdetermined", func(t *testing.T) {
+		got, err := LeastSquares(Matrix[int]{{1, 2, 2}}, vectors.Vector[int]{9})
+		if err != nil {
+			t.Fatalf("LeastSquares() error = %v", err)
+		}
+		want := vectors.Vector[float64]{1, 2, 2}
+		for i := range want {
+			if math.Abs(got.X[i]-want[i]) > 1e-12 {
+				t.Errorf("x[%d] = %v, want %v", i, got.X[i], want[i])
+			}
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		if _, err := LeastSquares(Matrix[int]{}, vectors.Vector[int]{1}); err == nil {
+			t.Error("expected error for empty matrix")
+		}
+		if _, err := LeastSquares(Matrix[int]{{1}}, vectors.Vector[int]{}); err == nil {
+			t.Error("expected error for empty vector")
+		}
+		if _, err := LeastSquares(Matrix[int]{{1}, {2}}, vectors.Vector[int]{1}); err == nil {
+			t.Error("expected error for mismatched dimensions")
+		}
+	})
+}
@@ -1,10 +1,70 @@
 package matrix
 
 import (
-	"errors"
+	"fmt"
 	"math"
+
+	"github.com/rickykimani/linalg/internal/negligible"
+)
+
+// QRMode selects the shape of the factors returned by HouseholderQR.
+type QRMode int
+
+const (
+	// QRThin returns the economy-size factorization: for an m×n matrix with
+	// k = min(m, n), Q is m×k with orthonormal columns and R is k×n.
+	QRThin QRMode = iota
+
+	// QRFull returns the complete factorization: Q is an m×m orthogonal
+	// matrix and R is m×n with zeros below row k.
+	QRFull
 )
 
+// HouseholderQR performs QR decomposition of a rectangular matrix using
+// Householder reflections.
+//
+// Parameters:
+//   - m: Input matrix of type Matrix[T] where T is int or float64, of any shape m×n
+//   - mode: QRThin for the economy-size factors or QRFull for a square Q
+//
+// Returns:
+//   - Matrix[float64]: Factor Q with orthonormal columns (see QRMode for its shape)
+//   - Matrix[float64]: Upper triangular (or trapezoidal) factor R
+//   - error: Returns error if the matrix is empty or non-rectangular, or the mode is
+//     unknown (ErrInvalidArgument)
+//
+// Each column is eliminated with an orthogonal reflection H = I - 2·v·vᵀ/(vᵀ·v),
+// so Q stays orthogonal to machine precision regardless of the conditioning of
+// the input, unlike the Gram-Schmidt process used by QRDecompose.
+//
+// Rank-deficient input is not an error: a column that is already zero below the
+// diagonal is skipped, which leaves a zero on the diagonal of R while Q remains
+// orthogonal. The diagonal entries of R may be negative.
+//
+// Time complexity: O(m·n·min(m, n)) for the factorization, plus the same again to form Q.
+func HouseholderQR[T int | float64](m Matrix[T], mode QRMode) (Matrix[float64], Matrix[float64], error) {
+	// Validate matrix structure
+	if err := m.Validate(); err != nil {
+		return nil, nil, err
+	}
+
+	if len(m) == 0 || len(m[0]) == 0 {
+		return nil, nil, ErrEmpty
+	}
+
+	h := newHouseholder(gtoFloat64Matrix(m))
+	k := len(h.rdiag)
+
+	switch mode {
+	case QRThin:
+		return h.q(k), h.r(k), nil
+	case QRFull:
+		return h.q(h.rows), h.r(h.rows), nil
+	default:
+		return nil, nil, fmt.Errorf("unknown QR mode %d: %w", mode, ErrInvalidArgument)
+	}
+}
+
 // QRDecompose performs QR decomposition of a matrix using the Gram-Schmidt process.
 //
 // QR decomposition factorizes a matrix A into a product Q*R where Q is an orthogonal
@@ -13,18 +73,26 @@ import (
 //
 // Parameters:
 //   - A: Input matrix of type Matrix[T] where T is int or float64
+//   - tol: Optional tolerance for linear dependence; a column whose norm after
+//     orthogonalization is no larger than it, relative to the norm of the
+//     original column, is dependent on the previous ones (default √ε)
 //
 // Returns:
 //   - Matrix[float64]: Orthogonal matrix Q where QᵀQ = I
 //   - Matrix[float64]: Upper triangular matrix R
-//   - error: Returns error if the matrix is empty, non-rectangular, or has linearly dependent columns
+//   - error: Returns error if the matrix is empty (ErrEmpty), non-square (ErrNotSquare)
+//     or non-rectangular, or has linearly dependent columns (ErrSingular)
 //
 // The function uses the classical Gram-Schmidt orthogonalization algorithm.
 // If the columns of A are linearly dependent (resulting in a zero norm during
-// orthogonalization), the function will return an error.
+// orthogonalization), the function will return an error. Classical Gram-Schmidt
+// loses orthogonality in proportion to the condition number of A, so rounding
+// can leave a dependent column with a remainder far above n·ε; the default
+// tolerance is therefore the looser √ε ≈ 1.5e-8. Use HouseholderQR for
+// rectangular or rank-deficient matrices.
 //
 // Time complexity: O(n²m) where n is the number of rows and m is the number of columns.
-func QRDecompose[T int | float64](m Matrix[T]) (Matrix[float64], Matrix[float64], error) {
+func QRDecompose[T int | float64](m Matrix[T], tol ...Tolerance) (Matrix[float64], Matrix[float64], error) {
 	// Validate matrix structure
 	if err := m.Validate(); err != nil {
 		return nil, nil, err
@@ -32,11 +100,11 @@ func QRDecompose[T int | float64](m Matrix[T]) (Matrix[float64], Matrix[float64]
 
 	n := len(m)
 	if n == 0 {
-		return nil, nil, errors.New("empty matrix")
+		return nil, nil, ErrEmpty
 	}
 
 	if !m.isSquare() {
-		return nil, nil, errors.New("matrix must be square")
+		return nil, nil, ErrNotSquare
 	}
 
 	cols := len(m[0])
@@ -55,8 +123,10 @@ func QRDecompose[T int | float64](m Matrix[T]) (Matrix[float64], Matrix[float64]
 	for j := range cols {
 		// Copy column j of m into vector v
 		v := make([]float64, n)
+		colNorm := 0.0
 		for i := range n {
 			v[i] = float64(m[i][j])
+			colNorm = math.Hypot(colNorm, v[i])
 		}
 
 		// Orthogonalize against previous columns
@@ -82,8 +152,8 @@ func QRDecompose[T int | float64](m Matrix[T]) (Matrix[float64], Matrix[float64]
 		norm = math.Sqrt(norm)
 
 		// Check for linear dependence
-		if norm < 1e-10 {
-			return nil, nil, errors.New("linearly dependent columns (zero norm)")
+		if norm <= negligible.Threshold(tol, math.Sqrt(machEps), colNorm) {
+			return nil, nil, fmt.Errorf("linearly dependent columns at column %d: %w", j, ErrSingular)
 		}
 		r[j][j] = norm
 
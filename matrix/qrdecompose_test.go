@@ -1,6 +1,7 @@
 package matrix
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 	"testing"
@@ -67,8 +68,8 @@ func TestQRDecompose_ErrorCases(t *testing.T) {
 		if err == nil {
 			t.Error("expected error for empty matrix, got nil")
 		}
-		if err.Error() != "empty matrix" {
-			t.Errorf("expected 'empty matrix' error, got: %v", err)
+		if !errors.Is(err, ErrEmpty) {
+			t.Errorf("expected ErrEmpty, got: %v", err)
 		}
 	})
 
@@ -97,8 +98,8 @@ func TestQRDecompose_ErrorCases(t *testing.T) {
 		}
 
 		_, _, err := QRDecompose(A)
-		if err == nil {
-			t.Error("expected error for non-square matrix, got nil")
+		if !errors.Is(err, ErrNotSquare) {
+			t.Errorf("expected ErrNotSquare, got: %v", err)
 		}
 	})
 
@@ -112,12 +113,135 @@ func TestQRDecompose_ErrorCases(t *testing.T) {
 		if err == nil {
 			t.Error("expected error for linearly dependent columns, got nil")
 		}
-		if err.Error() != "linearly dependent columns (zero norm)" {
-			t.Errorf("expected 'linearly dependent columns (zero norm)' error, got: %v", err)
+		if !errors.Is(err, ErrSingular) {
+			t.Errorf("expected ErrSingular, got: %v", err)
 		}
 	})
 }
 
+func TestHouseholderQR(t *testing.T) {
+	tests := []struct {
+		name  string
+		input Matrix[float64]
+	}{
+		{
+			name: "square",
+			input: Matrix[float64]{
+				{12, -51, 4},
+				{6, 167, -68},
+				{-4, 24, -41},
+			},
+		},
+		{
+			name: "tall",
+			input: Matrix[float64]{
+				{1, 2},
+				{3, 4},
+				{5, 6},
+				{7, 8},
+			},
+		},
+		{
+			name: "wide",
+			input: Matrix[float64]{
+				{1, 2, 3, 4},
+				{5, 6, 7, 8},
+			},
+		},
+		{
+			name: "rank deficient",
+			input: Matrix[float64]{
+				{1, 2, 3},
+				{2, 4, 6},
+				{3, 6, 9},
+			},
+		},
+		{
+			name: "zero column",
+			input: Matrix[float64]{
+				{0, 1},
+				{0, 2},
+				{0, 3},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		for _, mode := range []QRMode{QRThin, QRFull} {
+			name := tt.name + "/thin"
+			if mode == QRFull {
+				name = tt.name + "/full"
+			}
+			t.Run(name, func(t *testing.T) {
+				Q, R, err := HouseholderQR(tt.input, mode)
+				if err != nil {
+					t.Fatalf("HouseholderQR() error = %v", err)
+				}
+
+				rows, cols := tt.input.Rows(), tt.input.Cols()
+				k := min(rows, cols)
+				wantQCols, wantRRows := k, k
+				if mode == QRFull {
+					wantQCols, wantRRows = rows, rows
+				}
+				if Q.Rows() != rows || Q.Cols() != wantQCols {
+					t.Fatalf("Q is %d×%d, want %d×%d", Q.Rows(), Q.Cols(), rows, wantQCols)
+				}
+				if R.Rows() != wantRRows || R.Cols() != cols {
+					t.Fatalf("R is %d×%d, want %d×%d", R.Rows(), R.Cols(), wantRRows, cols)
+				}
+
+				// A = Q·R
+				QR, _ := Multiply(Q, R)
+				if !matricesAlmostEqual(QR, tt.input, 1e-12) {
+					t.Errorf("Q·R != A\ngot:\n%s", matrixToString(QR))
+				}
+
+				// QᵀQ = I to machine precision
+				QTQ, _ := Multiply(Transpose(Q), Q)
+				if !matricesAlmostEqual(QTQ, Identity(wantQCols), 1e-14) {
+					t.Errorf("QᵀQ != I\ngot:\n%s", matrixToString(QTQ))
+				}
+
+				// R is upper triangular
+				for i := range R {
+					for j := range min(i, cols) {
+						if R[i][j] != 0 {
+							t.Errorf("R not upper triangular at [%d][%d]: %g", i, j, R[i][j])
+						}
+					}
+				}
+			})
+		}
+	}
+}
+
+func TestHouseholderQR_ErrorCases(t *testing.T) {
+	if _, _, err := HouseholderQR(Matrix[int]{}, QRThin); err == nil {
+		t.Error("expected error for empty matrix")
+	}
+	if _, _, err := HouseholderQR(Matrix[int]{{1, 2}, {3}}, QRThin); err == nil {
+		t.Error("expected error for improper matrix")
+	}
+	if _, _, err := HouseholderQR(Matrix[int]{{1}}, QRMode(7)); err == nil {
+		t.Error("expected error for unknown mode")
+	}
+}
+
+func BenchmarkHouseholderQR(b *testing.B) {
+	sizes := []int{10, 50, 100}
+
+	for _, size := range sizes {
+		b.Run(fmt.Sprintf("%dx%d", size, size), func(b *testing.B) {
+			A := randomFloatMatrix(size, size)
+			b.ResetTimer()
+			for b.Loop() {
+				_, _, _ = HouseholderQR(A, QRThin)
+			}
+		})
+	}
+}
+
 func BenchmarkQRDecompose(b *testing.B) {
 	sizes := []int{10, 50, 100}
 
@@ -1,6 +1,10 @@
 package matrix
 
-import "math"
+import (
+	"math"
+
+	"github.com/rickykimani/linalg/internal/negligible"
+)
 
 // Rank calculates the rank of a matrix using Gaussian elimination.
 //
@@ -10,75 +14,57 @@ import "math"
 //
 // Parameters:
 //   - m: Input matrix of type Matrix[T] where T is int or float64
+//   - tol: Optional tolerance; a pivot no larger than it, relative to the
+//     Frobenius norm of m, counts as zero (default max(rows, cols)·ε)
 //
 // Returns:
 //   - int: The rank of the matrix
 //
 // The algorithm transforms the matrix to its row echelon form (REF) using
 // Gaussian elimination with partial pivoting for numerical stability,
-// then counts the number of non-zero rows in the resulting REF matrix.
+// and counts the pivots. Because the tolerance is relative, scaling a matrix
+// never changes its rank. For nearly rank-deficient matrices the singular
+// values give a more reliable answer; see SVD.
 //
 // For an empty matrix, the function returns 0.
 // Time complexity: O(min(n,m) × n × m) where n is the number of rows and m is the number of columns.
-func Rank[T int | float64](m Matrix[T]) int {
-	// Validate matrix structure
-	if err := m.Validate(); err != nil {
-		// For rank calculation, we should still proceed even with inconsistent rows
-		// but we need to ensure we have some data to work with
-	}
-
+func Rank[T int | float64](m Matrix[T], tol ...Tolerance) int {
 	rows := len(m)
 	if rows == 0 {
 		return 0
 	}
 
 	cols := len(m[0])
-	mat := cloneMatrix(m)
-
-	row := 0
-	rank := 0
-	const epsilon = 1e-10 // Small value for numerical stability
+	mat := gtoFloat64Matrix(m)
+	limit := negligible.Threshold(tol, epsTol(max(rows, cols)), frobenius(mat))
 
 	// Gaussian elimination to convert to row echelon form
-	for col := 0; col < cols && row < rows; col++ {
+	rank := 0
+	for col := 0; col < cols && rank < rows; col++ {
 		// Find pivot row with maximum absolute value in column
-		pivotRow := row
-		for i := row + 1; i < rows; i++ {
-			if abs(mat[i][col]) > abs(mat[pivotRow][col]) {
+		pivotRow := rank
+		for i := rank + 1; i < rows; i++ {
+			if math.Abs(mat[i][col]) > math.Abs(mat[pivotRow][col]) {
 				pivotRow = i
 			}
 		}
 
 		// Skip column if no viable pivot found
-		if math.Abs(float64(mat[pivotRow][col])) < epsilon {
+		if math.Abs(mat[pivotRow][col]) <= limit {
 			continue
 		}
 
 		// Swap rows
-		mat[row], mat[pivotRow] = mat[pivotRow], mat[row]
+		mat[rank], mat[pivotRow] = mat[pivotRow], mat[rank]
 
 		// Eliminate entries below the pivot
-		for i := row + 1; i < rows; i++ {
-			factor := mat[i][col] / mat[row][col]
+		for i := rank + 1; i < rows; i++ {
+			factor := mat[i][col] / mat[rank][col]
 			for j := col; j < cols; j++ {
-				mat[i][j] -= factor * mat[row][j]
+				mat[i][j] -= factor * mat[rank][j]
 			}
 		}
-		row++
-	}
-
-	// Count non-zero rows to determine rank
-	for i := range rows {
-		nonZero := false
-		for j := range cols {
-			if math.Abs(float64(mat[i][j])) > epsilon {
-				nonZero = true
-				break
-			}
-		}
-		if nonZero {
-			rank++
-		}
+		rank++
 	}
 	return rank
 }
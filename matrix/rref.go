@@ -0,0 +1,204 @@
+package matrix
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/rickykimani/linalg/internal/negligible"
+)
+
+// RowOpKind identifies one of the three elementary row operations.
+type RowOpKind int
+
+const (
+	// RowSwap exchanges rows I and J.
+	RowSwap RowOpKind = iota
+	// RowScale multiplies row I by Factor.
+	RowScale
+	// RowAdd adds Factor times row J to row I.
+	RowAdd
+)
+
+// RowOp is an elementary row operation performed during Gauss–Jordan
+// elimination. Rows are numbered from zero.
+type RowOp struct {
+	Kind   RowOpKind
+	I, J   int     // Rows operated on; J is unused by RowScale
+	Factor float64 // Multiplier for RowScale and RowAdd
+}
+
+// String formats the operation in textbook notation with rows numbered from
+// one, such as "R1 ↔ R3", "R2 → 0.5·R2" or "R3 → R3 - 2·R1".
+func (op RowOp) String() string {
+	i, j := op.I+1, op.J+1
+	switch op.Kind {
+	case RowSwap:
+		return fmt.Sprintf("R%d ↔ R%d", i, j)
+	case RowScale:
+		return fmt.Sprintf("R%d → %g·R%d", i, op.Factor, i)
+	case RowAdd:
+		if op.Factor < 0 {
+			return fmt.Sprintf("R%d → R%d - %g·R%d", i, i, -op.Factor, j)
+		}
+		return fmt.Sprintf("R%d → R%d + %g·R%d", i, i, op.Factor, j)
+	default:
+		return fmt.Sprintf("RowOp(%d)", int(op.Kind))
+	}
+}
+
+// Apply performs the row operation on m in place.
+//
+// Returns an error wrapping ErrInvalidArgument if a row index is out of bounds
+// for m.
+func (op RowOp) Apply(m Matrix[float64]) error {
+	if op.I < 0 || op.I >= len(m) || (op.Kind != RowScale && (op.J < 0 || op.J >= len(m))) {
+		return fmt.Errorf("row operation %v out of bounds for matrix with %d rows: %w", op, len(m), ErrInvalidArgument)
+	}
+
+	switch op.Kind {
+	case RowSwap:
+		m[op.I], m[op.J] = m[op.J], m[op.I]
+	case RowScale:
+		for k := range m[op.I] {
+			m[op.I][k] *= op.Factor
+		}
+	case RowAdd:
+		for k := range m[op.I] {
+			m[op.I][k] += op.Factor * m[op.J][k]
+		}
+	}
+	return nil
+}
+
+// RREF computes the reduced row echelon form of a matrix by Gauss–Jordan
+// elimination.
+//
+// Parameters:
+//   - m: Input matrix of type Matrix[T] where T is int or float64
+//   - tol: Optional tolerance; a pivot candidate no larger than it, relative to
+//     the Frobenius norm of m, counts as zero (default max(rows, cols)·ε)
+//
+// Returns:
+//   - Matrix[float64]: The reduced row echelon form, in which every pivot is 1
+//     and is the only nonzero element of its column
+//   - []int: The pivot columns in increasing order; their count is the rank
+//   - error: An error if the matrix is invalid or empty (ErrEmpty)
+//
+// Pivots are chosen by partial pivoting, as in Rank, so the two agree on the
+// rank for the same tolerance. Elements that the tolerance treats as zero are
+// set to exactly zero. Use RREFSteps to also obtain the row operations, or the
+// exact package for a reduced row echelon form free of rounding error.
+//
+// Example:
+//
+//	m := Matrix[int]{{1, 2, 1}, {2, 4, 0}}
+//	r, pivots, _ := RREF(m)  // r = [[1, 2, 0], [0, 0, 1]], pivots = [0, 2]
+//
+// Time complexity: O(min(n,m) × n × m) where n is the number of rows and m is the number of columns.
+func RREF[T int | float64](m Matrix[T], tol ...Tolerance) (Matrix[float64], []int, error) {
+	r, pivots, _, err := rref(m, tol, false)
+	return r, pivots, err
+}
+
+// RREFSteps computes the reduced row echelon form of a matrix as RREF does,
+// and also returns the sequence of elementary row operations that produced it.
+//
+// Parameters:
+//   - m: Input matrix of type Matrix[T] where T is int or float64
+//   - tol: Optional tolerance, as for RREF
+//
+// Returns:
+//   - Matrix[float64]: The reduced row echelon form
+//   - []int: The pivot columns in increasing order
+//   - []RowOp: The row operations in the order they were performed
+//   - error: An error if the matrix is invalid or empty (ErrEmpty)
+//
+// Applying the operations in order to a copy of m reproduces the result, up to
+// rounding and the elements that the tolerance set to zero. Operations that
+// would change nothing, such as scaling a pivot that is already 1 or adding a
+// multiple of the pivot row to a row whose element is already 0, are omitted.
+//
+// Example:
+//
+//	m := Matrix[int]{{0, 2}, {1, 1}}
+//	_, _, steps, _ := RREFSteps(m)
+//	for _, op := range steps {
+//		fmt.Println(op)  // R1 ↔ R2, R2 → 0.5·R2, R1 → R1 - 1·R2
+//	}
+func RREFSteps[T int | float64](m Matrix[T], tol ...Tolerance) (Matrix[float64], []int, []RowOp, error) {
+	return rref(m, tol, true)
+}
+
+// rref performs Gauss–Jordan elimination with partial pivoting on a copy of m,
+// recording the row operations if record is set.
+func rref[T int | float64](m Matrix[T], tol []Tolerance, record bool) (Matrix[float64], []int, []RowOp, error) {
+	if err := m.Validate(); err != nil {
+		return nil, nil, nil, fmt.Errorf("invalid matrix: %w", err)
+	}
+	if len(m) == 0 || len(m[0]) == 0 {
+		return nil, nil, nil, ErrEmpty
+	}
+
+	rows, cols := len(m), len(m[0])
+	mat := gtoFloat64Matrix(m)
+	limit := negligible.Threshold(tol, epsTol(max(rows, cols)), frobenius(mat))
+
+	var pivots []int
+	var ops []RowOp
+	r := 0
+	for col := 0; col < cols && r < rows; col++ {
+		// Find pivot row with maximum absolute value in column
+		pivotRow := r
+		for i := r + 1; i < rows; i++ {
+			if math.Abs(mat[i][col]) > math.Abs(mat[pivotRow][col]) {
+				pivotRow = i
+			}
+		}
+
+		// Skip column if no viable pivot found, clearing its negligible elements
+		if math.Abs(mat[pivotRow][col]) <= limit {
+			for i := r; i < rows; i++ {
+				mat[i][col] = 0
+			}
+			continue
+		}
+
+		if pivotRow != r {
+			mat[r], mat[pivotRow] = mat[pivotRow], mat[r]
+			if record {
+				ops = append(ops, RowOp{Kind: RowSwap, I: r, J: pivotRow})
+			}
+		}
+
+		// Scale the pivot row so that the pivot is 1
+		if p := mat[r][col]; p != 1 {
+			f := 1 / p
+			for j := col + 1; j < cols; j++ {
+				mat[r][j] *= f
+			}
+			mat[r][col] = 1
+			if record {
+				ops = append(ops, RowOp{Kind: RowScale, I: r, Factor: f})
+			}
+		}
+
+		// Clear the pivot column above and below the pivot
+		for i := range rows {
+			f := mat[i][col]
+			if i == r || f == 0 {
+				continue
+			}
+			for j := col + 1; j < cols; j++ {
+				mat[i][j] -= f * mat[r][j]
+			}
+			mat[i][col] = 0
+			if record {
+				ops = append(ops, RowOp{Kind: RowAdd, I: i, J: r, Factor: -f})
+			}
+		}
+
+		pivots = append(pivots, col)
+		r++
+	}
+	return mat, pivots, ops, nil
+}
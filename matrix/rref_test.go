@@ -0,0 +1,225 @@
+package matrix
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+)
+
+func TestRREF(t *testing.T) {
+	tests := []struct {
+		name     string
+		matrix   Matrix[float64]
+		expected Matrix[float64]
+		pivots   []int
+	}{
+		{
+			name:     "Full rank",
+			matrix:   Matrix[float64]{{2, 1}, {1, 3}},
+			expected: Matrix[float64]{{1, 0}, {0, 1}},
+			pivots:   []int{0, 1},
+		},
+		{
+			name:     "Skipped column",
+			matrix:   Matrix[float64]{{1, 2, 1}, {2, 4, 0}},
+			expected: Matrix[float64]{{1, 2, 0}, {0, 0, 1}},
+			pivots:   []int{0, 2},
+		},
+		{
+			name:     "Dependent row",
+			matrix:   Matrix[float64]{{0, 3, 6}, {2, 4, 2}, {1, 2, 1}},
+			expected: Matrix[float64]{{1, 0, -3}, {0, 1, 2}, {0, 0, 0}},
+			pivots:   []int{0, 1},
+		},
+		{
+			name:     "Augmented system",
+			matrix:   Matrix[float64]{{1, 1, 1, 6}, {0, 2, 5, -4}, {2, 5, -1, 27}},
+			expected: Matrix[float64]{{1, 0, 0, 5}, {0, 1, 0, 3}, {0, 0, 1, -2}},
+			pivots:   []int{0, 1, 2},
+		},
+		{
+			name:     "Tall matrix",
+			matrix:   Matrix[float64]{{1, 2}, {2, 4}, {3, 7}},
+			expected: Matrix[float64]{{1, 0}, {0, 1}, {0, 0}},
+			pivots:   []int{0, 1},
+		},
+		{
+			name:     "Zero matrix",
+			matrix:   Matrix[float64]{{0, 0}, {0, 0}},
+			expected: Matrix[float64]{{0, 0}, {0, 0}},
+			pivots:   nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			original := cloneMatrix(tt.matrix)
+			result, pivots, err := RREF(tt.matrix)
+			if err != nil {
+				t.Fatalf("RREF() error = %v", err)
+			}
+			if !matricesAlmostEqual(result, tt.expected, 1e-12) {
+				t.Errorf("expected\n%s\ngot\n%s", matrixToString(tt.expected), matrixToString(result))
+			}
+			if !slices.Equal(pivots, tt.pivots) {
+				t.Errorf("pivots = %v, expected %v", pivots, tt.pivots)
+			}
+			if !matricesAlmostEqual(tt.matrix, original, 0) {
+				t.Error("RREF() modified its argument")
+			}
+			if rank := Rank(tt.matrix); rank != len(pivots) {
+				t.Errorf("Rank() = %d, but RREF() found %d pivots", rank, len(pivots))
+			}
+		})
+	}
+}
+
+func TestRREF_Tolerance(t *testing.T) {
+	// The second row is the first plus noise of relative size 1e-10
+	m := Matrix[float64]{{1, 2, 3}, {1, 2, 3 + 1e-10}}
+
+	if _, pivots, _ := RREF(m); len(pivots) != 2 {
+		t.Errorf("default tolerance: pivots = %v, expected 2", pivots)
+	}
+
+	result, pivots, _ := RREF(m, Tolerance{Rel: 1e-8})
+	if len(pivots) != 1 {
+		t.Errorf("loose tolerance: pivots = %v, expected 1", pivots)
+	}
+	// The negligible second row is cleared to exact zeros
+	for j, v := range result[1] {
+		if v != 0 {
+			t.Errorf("result[1][%d] = %g, expected 0", j, v)
+		}
+	}
+}
+
+func TestRREFSteps(t *testing.T) {
+	tests := []struct {
+		name   string
+		matrix Matrix[float64]
+		steps  []RowOp
+	}{
+		{
+			name:   "Swap, scale and eliminate",
+			matrix: Matrix[float64]{{0, 2}, {1, 1}},
+			steps: []RowOp{
+				{Kind: RowSwap, I: 0, J: 1},
+				{Kind: RowScale, I: 1, Factor: 0.5},
+				{Kind: RowAdd, I: 0, J: 1, Factor: -1},
+			},
+		},
+		{
+			name:   "Identity needs no steps",
+			matrix: Identity(3),
+			steps:  nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, steps, err := RREFSteps(tt.matrix)
+			if err != nil {
+				t.Fatalf("RREFSteps() error = %v", err)
+			}
+			if !slices.Equal(steps, tt.steps) {
+				t.Errorf("steps = %v, expected %v", steps, tt.steps)
+			}
+		})
+	}
+}
+
+func TestRREFSteps_Replay(t *testing.T) {
+	for _, size := range [][2]int{{3, 3}, {4, 6}, {6, 4}} {
+		t.Run(fmt.Sprintf("%dx%d", size[0], size[1]), func(t *testing.T) {
+			m := randomFloatMatrix(size[0], size[1])
+			// Make the last row dependent on the first two
+			for j := range m[0] {
+				m[len(m)-1][j] = 2*m[0][j] - m[1][j]
+			}
+
+			tol := Tolerance{Rel: 1e-10}
+			result, pivots, steps, err := RREFSteps(m, tol)
+			if err != nil {
+				t.Fatalf("RREFSteps() error = %v", err)
+			}
+			if expected, _, _ := RREF(m, tol); !matricesAlmostEqual(result, expected, 0) {
+				t.Error("RREFSteps() and RREF() disagree")
+			}
+			if len(pivots) != min(size[0]-1, size[1]) {
+				t.Errorf("found %d pivots, expected %d", len(pivots), min(size[0]-1, size[1]))
+			}
+
+			replay := cloneMatrix(m)
+			for _, op := range steps {
+				if err := op.Apply(replay); err != nil {
+					t.Fatalf("Apply(%v) error = %v", op, err)
+				}
+			}
+			if !matricesAlmostEqual(replay, result, 1e-9) {
+				t.Errorf("replaying the steps gives\n%s\nexpected\n%s", matrixToString(replay), matrixToString(result))
+			}
+		})
+	}
+}
+
+func TestRowOp_String(t *testing.T) {
+	tests := []struct {
+		op       RowOp
+		expected string
+	}{
+		{RowOp{Kind: RowSwap, I: 0, J: 2}, "R1 ↔ R3"},
+		{RowOp{Kind: RowScale, I: 1, Factor: 0.5}, "R2 → 0.5·R2"},
+		{RowOp{Kind: RowAdd, I: 2, J: 0, Factor: -2}, "R3 → R3 - 2·R1"},
+		{RowOp{Kind: RowAdd, I: 0, J: 1, Factor: 1.5}, "R1 → R1 + 1.5·R2"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.op.String(); got != tt.expected {
+			t.Errorf("String() = %q, expected %q", got, tt.expected)
+		}
+	}
+}
+
+func TestRowOp_Apply(t *testing.T) {
+	m := Matrix[float64]{{1, 2}, {3, 4}}
+	if err := (RowOp{Kind: RowAdd, I: 1, J: 0, Factor: -3}).Apply(m); err != nil {
+		t.Fatalf("Apply() error = %v", err)
+	}
+	if expected := (Matrix[float64]{{1, 2}, {0, -2}}); !matricesAlmostEqual(m, expected, 0) {
+		t.Errorf("expected\n%s\ngot\n%s", matrixToString(expected), matrixToString(m))
+	}
+	for _, op := range []RowOp{
+		{Kind: RowSwap, I: 0, J: 2},
+		{Kind: RowScale, I: -1, Factor: 2},
+		{Kind: RowAdd, I: 1, J: -1, Factor: 1},
+	} {
+		if err := op.Apply(m); !errors.Is(err, ErrInvalidArgument) {
+			t.Errorf("Apply(%v): expected ErrInvalidArgument for an out-of-bounds row, got %v", op, err)
+		}
+	}
+}
+
+func TestRREF_Errors(t *testing.T) {
+	if _, _, err := RREF(Matrix[int]{}); !errors.Is(err, ErrEmpty) {
+		t.Errorf("expected ErrEmpty, got %v", err)
+	}
+	if _, _, _, err := RREFSteps(Matrix[int]{{1, 2}, {3}}); err == nil {
+		t.Error("expected error for improper matrix")
+	}
+}
+
+func BenchmarkRREF(b *testing.B) {
+	sizes := []int{10, 50, 100}
+
+	for _, size := range sizes {
+		b.Run(fmt.Sprintf("%dx%d", size, size), func(b *testing.B) {
+			A := randomFloatMatrix(size, size)
+			b.ResetTimer()
+			for b.Loop() {
+				_, _, _ = RREF(A)
+			}
+		})
+	}
+}
@@ -0,0 +1,379 @@
+package matrix
+
+import (
+	"fmt"
+	"math"
+)
+
+// AddSame adds two matrices of the same element type, keeping that type.
+//
+// Parameters:
+//   - a: First matrix of type Matrix[T] where T is int or float64
+//   - b: Second matrix of type Matrix[T], with the same dimensions as a
+//
+// Returns:
+//   - Matrix[T]: A new matrix where each element is a[i][j] + b[i][j]
+//   - error: An error if matrices are empty (ErrEmpty) or have different dimensions
+//     (*DimensionError, matching ErrDimensionMismatch)
+//
+// Unlike Add, which always converts to float64, the sum of two Matrix[int] is
+// a Matrix[int] and stays exact beyond 2⁵³. Integer overflow wraps around as
+// in Go's own arithmetic; use AddChecked to detect it.
+func AddSame[T int | float64](a, b Matrix[T]) (Matrix[T], error) {
+	return elementwiseSame("AddSame", a, b, func(x, y T) (T, bool) { return x + y, true })
+}
+
+// SubtractSame subtracts two matrices of the same element type, keeping that type.
+//
+// Parameters:
+//   - a: First matrix of type Matrix[T] where T is int or float64
+//   - b: Second matrix of type Matrix[T], with the same dimensions as a
+//
+// Returns:
+//   - Matrix[T]: A new matrix where each element is a[i][j] - b[i][j]
+//   - error: An error if matrices are empty (ErrEmpty) or have different dimensions
+//     (*DimensionError, matching ErrDimensionMismatch)
+//
+// Integer overflow wraps around; use SubtractChecked to detect it.
+func SubtractSame[T int | float64](a, b Matrix[T]) (Matrix[T], error) {
+	return elementwiseSame("SubtractSame", a, b, func(x, y T) (T, bool) { return x - y, true })
+}
+
+// MultiplySame multiplies two matrices of the same element type, keeping that type.
+//
+// Parameters:
+//   - a: First matrix of type Matrix[T] where T is int or float64
+//   - b: Second matrix of type Matrix[T]
+//
+// Returns:
+//   - Matrix[T]: The product a·b
+//   - error: An error if either matrix is empty (ErrEmpty) or if the dimensions are
+//     incompatible (*DimensionError, matching ErrDimensionMismatch)
+//
+// Integer overflow wraps around; use MultiplyChecked to detect it, or
+// MultiplyMod to work modulo an integer.
+//
+// Time complexity: O(rows × cols × common), as for Multiply.
+func MultiplySame[T int | float64](a, b Matrix[T]) (Matrix[T], error) {
+	if err := checkProduct("MultiplySame", a, b); err != nil {
+		return nil, err
+	}
+	result, _ := multiplySame(a, b, func(x, y T) (T, bool) { return x * y, true }, func(x, y T) (T, bool) { return x + y, true })
+	return result, nil
+}
+
+// ScaleSame multiplies each element of a matrix by a scalar of the same type,
+// keeping that type.
+//
+// Parameters:
+//   - s: Scalar value of type T where T is int or float64
+//   - m: Matrix of type Matrix[T]
+//
+// Returns:
+//   - Matrix[T]: A new matrix with each element multiplied by s
+//
+// An invalid or empty matrix yields an empty matrix, as with Scale. Integer
+// overflow wraps around; use ScaleChecked to detect it.
+func ScaleSame[T int | float64](s T, m Matrix[T]) Matrix[T] {
+	if err := m.Validate(); err != nil || len(m) == 0 {
+		return Matrix[T]{}
+	}
+
+	result, _ := scaleSame(s, m, func(x, y T) (T, bool) { return x * y, true })
+	return result
+}
+
+// PowSame raises a square matrix to a non-negative integer power, keeping its
+// element type.
+//
+// Parameters:
+//   - m: A square matrix of type Matrix[T] where T is int or float64
+//   - n: Non-negative power to which the matrix is raised
+//
+// Returns:
+//   - Matrix[T]: The matrix mⁿ, or the identity for n = 0
+//   - error: An error if the matrix is invalid, empty (ErrEmpty) or not square
+//     (ErrNotSquare), or n is negative (ErrInvalidArgument)
+//
+// Negative powers are rejected because the inverse of an integer matrix is
+// rarely an integer matrix; use Pow for them. Integer overflow wraps around;
+// use PowChecked to detect it, or PowMod to work modulo an integer.
+//
+// Time complexity: O(log n × m³) where m is the matrix dimension.
+func PowSame[T int | float64](m Matrix[T], n int) (Matrix[T], error) {
+	if err := checkPow(m, n); err != nil {
+		return nil, err
+	}
+	return powSame(m, n, MultiplySame[T])
+}
+
+// AddChecked adds two integer matrices, reporting overflow instead of
+// wrapping around.
+//
+// Parameters:
+//   - a: First matrix of type Matrix[int]
+//   - b: Second matrix of type Matrix[int], with the same dimensions as a
+//
+// Returns:
+//   - Matrix[int]: A new matrix where each element is a[i][j] + b[i][j]
+//   - error: An error if matrices are empty (ErrEmpty), have different dimensions
+//     (*DimensionError), or an element of the sum does not fit in an int (ErrOverflow)
+func AddChecked(a, b Matrix[int]) (Matrix[int], error) {
+	return elementwiseSame("AddChecked", a, b, addInt)
+}
+
+// SubtractChecked subtracts two integer matrices, reporting overflow instead
+// of wrapping around.
+//
+// Parameters:
+//   - a: First matrix of type Matrix[int]
+//   - b: Second matrix of type Matrix[int], with the same dimensions as a
+//
+// Returns:
+//   - Matrix[int]: A new matrix where each element is a[i][j] - b[i][j]
+//   - error: An error if matrices are empty (ErrEmpty), have different dimensions
+//     (*DimensionError), or an element of the difference does not fit in an int
+//     (ErrOverflow)
+func SubtractChecked(a, b Matrix[int]) (Matrix[int], error) {
+	return elementwiseSame("SubtractChecked", a, b, subInt)
+}
+
+// MultiplyChecked multiplies two integer matrices, reporting overflow instead
+// of wrapping around.
+//
+// Parameters:
+//   - a: First matrix of type Matrix[int]
+//   - b: Second matrix of type Matrix[int]
+//
+// Returns:
+//   - Matrix[int]: The product a·b
+//   - error: An error if either matrix is empty (ErrEmpty), the dimensions are
+//     incompatible (*DimensionError), or a product or partial sum does not fit
+//     in an int (ErrOverflow)
+//
+// A partial sum is checked as it is accumulated, so an element whose final
+// value fits in an int is still reported if an intermediate sum overflows.
+//
+// Time complexity: O(rows × cols × common), as for Multiply.
+func MultiplyChecked(a, b Matrix[int]) (Matrix[int], error) {
+	if err := checkProduct("MultiplyChecked", a, b); err != nil {
+		return nil, err
+	}
+	result, at := multiplySame(a, b, mulInt, addInt)
+	if result == nil {
+		return nil, fmt.Errorf("element (%d, %d) of the product: %w", at[0], at[1], ErrOverflow)
+	}
+	return result, nil
+}
+
+// ScaleChecked multiplies each element of an integer matrix by s, reporting
+// overflow instead of wrapping around.
+//
+// Parameters:
+//   - s: Scalar value
+//   - m: Matrix of type Matrix[int]
+//
+// Returns:
+//   - Matrix[int]: A new matrix with each element multiplied by s
+//   - error: An error if the matrix is invalid or empty (ErrEmpty), or an
+//     element of the result does not fit in an int (ErrOverflow)
+func ScaleChecked(s int, m Matrix[int]) (Matrix[int], error) {
+	if err := m.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid matrix: %w", err)
+	}
+	if len(m) == 0 {
+		return nil, ErrEmpty
+	}
+
+	result, at := scaleSame(s, m, mulInt)
+	if result == nil {
+		return nil, fmt.Errorf("element (%d, %d): %w", at[0], at[1], ErrOverflow)
+	}
+	return result, nil
+}
+
+// PowChecked raises a square integer matrix to a non-negative power, reporting
+// overflow instead of wrapping around.
+//
+// Parameters:
+//   - m: A square matrix of type Matrix[int]
+//   - n: Non-negative power to which the matrix is raised
+//
+// Returns:
+//   - Matrix[int]: The matrix mⁿ, or the identity for n = 0
+//   - error: An error if the matrix is invalid, empty (ErrEmpty) or not square
+//     (ErrNotSquare), n is negative (ErrInvalidArgument), or an intermediate
+//     product overflows (ErrOverflow)
+//
+// Overflow in any intermediate product of the binary exponentiation is
+// reported, even in the rare case, such as a nilpotent matrix, where mⁿ
+// itself would fit in an int.
+//
+// Time complexity: O(log n × m³) where m is the matrix dimension.
+func PowChecked(m Matrix[int], n int) (Matrix[int], error) {
+	if err := checkPow(m, n); err != nil {
+		return nil, err
+	}
+	return powSame(m, n, MultiplyChecked)
+}
+
+// elementwiseSame applies op to corresponding elements of a and b, which must
+// have the same dimensions. A false result from op reports overflow.
+func elementwiseSame[T int | float64](name string, a, b Matrix[T], op func(x, y T) (T, bool)) (Matrix[T], error) {
+	if err := a.Validate(); err != nil {
+		return nil, fmt.Errorf("first matrix: %w", err)
+	}
+	if err := b.Validate(); err != nil {
+		return nil, fmt.Errorf("second matrix: %w", err)
+	}
+	if len(a) == 0 || len(b) == 0 {
+		return nil, ErrEmpty
+	}
+	if a.Rows() != b.Rows() || a.Cols() != b.Cols() {
+		return nil, dimensionError(name, a.shape(), b.shape())
+	}
+
+	result := make(Matrix[T], len(a))
+	for i := range a {
+		result[i] = make([]T, len(a[i]))
+		for j := range a[i] {
+			v, ok := op(a[i][j], b[i][j])
+			if !ok {
+				return nil, fmt.Errorf("element (%d, %d): %w", i, j, ErrOverflow)
+			}
+			result[i][j] = v
+		}
+	}
+	return result, nil
+}
+
+// checkProduct validates a and b as operands of the product a·b.
+func checkProduct[T int | float64](name string, a, b Matrix[T]) error {
+	if err := a.Validate(); err != nil {
+		return fmt.Errorf("first matrix: %w", err)
+	}
+	if err := b.Validate(); err != nil {
+		return fmt.Errorf("second matrix: %w", err)
+	}
+	if len(a) == 0 || len(b) == 0 {
+		return ErrEmpty
+	}
+	if len(a[0]) != len(b) {
+		return dimensionError(name, a.shape(), b.shape())
+	}
+	return nil
+}
+
+// multiplySame computes a·b with the given element operations, which report
+// overflow by returning false. On overflow it returns nil and the position of
+// the offending element.
+func multiplySame[T int | float64](a, b Matrix[T], mul, add func(x, y T) (T, bool)) (Matrix[T], [2]int) {
+	rows, cols := len(a), len(b[0])
+	result := make(Matrix[T], rows)
+	for i := range rows {
+		result[i] = make([]T, cols)
+		for k, aik := range a[i] {
+			if aik == 0 {
+				continue
+			}
+			for j, bkj := range b[k] {
+				p, ok := mul(aik, bkj)
+				if ok {
+					p, ok = add(result[i][j], p)
+				}
+				if !ok {
+					return nil, [2]int{i, j}
+				}
+				result[i][j] = p
+			}
+		}
+	}
+	return result, [2]int{}
+}
+
+// scaleSame computes s·m with the given multiplication, which reports
+// overflow by returning false. On overflow it returns nil and the position of
+// the offending element.
+func scaleSame[T int | float64](s T, m Matrix[T], mul func(x, y T) (T, bool)) (Matrix[T], [2]int) {
+	result := make(Matrix[T], len(m))
+	for i := range m {
+		result[i] = make([]T, len(m[i]))
+		for j := range m[i] {
+			v, ok := mul(s, m[i][j])
+			if !ok {
+				return nil, [2]int{i, j}
+			}
+			result[i][j] = v
+		}
+	}
+	return result, [2]int{}
+}
+
+// checkPow validates a square matrix and a non-negative power.
+func checkPow[T int | float64](m Matrix[T], n int) error {
+	if err := m.Validate(); err != nil {
+		return fmt.Errorf("invalid matrix: %w", err)
+	}
+	if len(m) == 0 {
+		return ErrEmpty
+	}
+	if !m.isSquare() {
+		return ErrNotSquare
+	}
+	if n < 0 {
+		return fmt.Errorf("negative power %d is not supported for integer-preserving powers; use Pow: %w", n, ErrInvalidArgument)
+	}
+	return nil
+}
+
+// powSame computes mⁿ for n ≥ 0 by binary exponentiation with the given
+// multiplication.
+func powSame[T int | float64](m Matrix[T], n int, multiply func(a, b Matrix[T]) (Matrix[T], error)) (Matrix[T], error) {
+	size := len(m)
+	result := make(Matrix[T], size)
+	for i := range size {
+		result[i] = make([]T, size)
+		result[i][i] = 1
+	}
+
+	base := cloneMatrix(m)
+	var err error
+	for n > 0 {
+		if n%2 == 1 {
+			if result, err = multiply(result, base); err != nil {
+				return nil, err
+			}
+		}
+		n /= 2
+		if n > 0 {
+			if base, err = multiply(base, base); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return result, nil
+}
+
+// addInt returns x + y and whether the sum fits in an int.
+func addInt(x, y int) (int, bool) {
+	s := x + y
+	return s, (s > x) == (y > 0)
+}
+
+// subInt returns x - y and whether the difference fits in an int.
+func subInt(x, y int) (int, bool) {
+	d := x - y
+	return d, (d < x) == (y > 0)
+}
+
+// mulInt returns x·y and whether the product fits in an int.
+func mulInt(x, y int) (int, bool) {
+	if x == 0 || y == 0 {
+		return 0, true
+	}
+	p := x * y
+	if (x == -1 && y == math.MinInt) || (y == -1 && x == math.MinInt) || p/y != x {
+		return p, false
+	}
+	return p, true
+}
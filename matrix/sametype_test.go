@@ -0,0 +1,267 @@
+package matrix
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+)
+
+// intMatricesEqual reports whether a and b have the same shape and elements.
+func intMatricesEqual(a, b Matrix[int]) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if len(a[i]) != len(b[i]) {
+			return false
+		}
+		for j := range a[i] {
+			if a[i][j] != b[i][j] {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func TestSameType(t *testing.T) {
+	// Beyond 2⁵³ float64 can no longer represent every integer
+	big := 1<<60 + 1
+	a := Matrix[int]{{big, 2}, {3, 4}}
+	b := Matrix[int]{{1, -2}, {5, 6}}
+
+	sum, err := AddSame(a, b)
+	if err != nil {
+		t.Fatalf("AddSame() error = %v", err)
+	}
+	if expected := (Matrix[int]{{big + 1, 0}, {8, 10}}); !intMatricesEqual(sum, expected) {
+		t.Errorf("AddSame() = %v, expected %v", sum, expected)
+	}
+
+	diff, err := SubtractSame(a, b)
+	if err != nil {
+		t.Fatalf("SubtractSame() error = %v", err)
+	}
+	if expected := (Matrix[int]{{big - 1, 4}, {-2, -2}}); !intMatricesEqual(diff, expected) {
+		t.Errorf("SubtractSame() = %v, expected %v", diff, expected)
+	}
+
+	product, err := MultiplySame(a, b)
+	if err != nil {
+		t.Fatalf("MultiplySame() error = %v", err)
+	}
+	if expected := (Matrix[int]{{big + 10, -2*big + 12}, {23, 18}}); !intMatricesEqual(product, expected) {
+		t.Errorf("MultiplySame() = %v, expected %v", product, expected)
+	}
+
+	if scaled := ScaleSame(3, a); !intMatricesEqual(scaled, Matrix[int]{{3 * big, 6}, {9, 12}}) {
+		t.Errorf("ScaleSame() = %v", scaled)
+	}
+
+	// The float64 variants agree with the mixed-type functions
+	x := randomFloatMatrix(4, 4)
+	y := randomFloatMatrix(4, 4)
+	got, _ := MultiplySame(x, y)
+	expected, _ := Multiply(x, y)
+	if !matricesAlmostEqual(got, expected, 1e-12) {
+		t.Error("MultiplySame() on float64 differs from Multiply()")
+	}
+}
+
+func TestPowSame(t *testing.T) {
+	fib := Matrix[int]{{1, 1}, {1, 0}}
+
+	// Fibonacci numbers up to F₉₂, the largest that fits in an int64
+	f0, f1 := 0, 1
+	for n := 1; n <= 92; n++ {
+		f0, f1 = f1, f0+f1
+		if n%13 != 0 && n != 92 {
+			continue
+		}
+		p, err := PowSame(fib, n)
+		if err != nil {
+			t.Fatalf("PowSame(%d) error = %v", n, err)
+		}
+		if p[0][1] != f0 {
+			t.Errorf("PowSame(%d)[0][1] = %d, expected F%d = %d", n, p[0][1], n, f0)
+		}
+	}
+
+	if p, _ := PowSame(fib, 0); !intMatricesEqual(p, Matrix[int]{{1, 0}, {0, 1}}) {
+		t.Errorf("PowSame(0) = %v, expected the identity", p)
+	}
+
+	x := Scale(0.5, randomFloatMatrix(3, 3))
+	got, _ := PowSame(x, 5)
+	expected, _ := Pow(x, 5)
+	if !matricesAlmostEqual(got, expected, 1e-9) {
+		t.Error("PowSame() on float64 differs from Pow()")
+	}
+}
+
+func TestChecked(t *testing.T) {
+	tests := []struct {
+		name string
+		fn   func() (Matrix[int], error)
+		want Matrix[int] // nil when overflow is expected
+	}{
+		{
+			name: "AddChecked",
+			fn:   func() (Matrix[int], error) { return AddChecked(Matrix[int]{{math.MaxInt - 1}}, Matrix[int]{{1}}) },
+			want: Matrix[int]{{math.MaxInt}},
+		},
+		{
+			name: "AddChecked overflow",
+			fn:   func() (Matrix[int], error) { return AddChecked(Matrix[int]{{1, math.MaxInt}}, Matrix[int]{{1, 1}}) },
+		},
+		{
+			name: "AddChecked negative overflow",
+			fn:   func() (Matrix[int], error) { return AddChecked(Matrix[int]{{math.MinInt}}, Matrix[int]{{-1}}) },
+		},
+		{
+			name: "SubtractChecked",
+			fn:   func() (Matrix[int], error) { return SubtractChecked(Matrix[int]{{math.MinInt + 1}}, Matrix[int]{{1}}) },
+			want: Matrix[int]{{math.MinInt}},
+		},
+		{
+			name: "SubtractChecked overflow",
+			fn:   func() (Matrix[int], error) { return SubtractChecked(Matrix[int]{{0}}, Matrix[int]{{math.MinInt}}) },
+		},
+		{
+			name: "ScaleChecked",
+			fn:   func() (Matrix[int], error) { return ScaleChecked(-1, Matrix[int]{{math.MaxInt, 2}}) },
+			want: Matrix[int]{{-math.MaxInt, -2}},
+		},
+		{
+			name: "ScaleChecked overflow",
+			fn:   func() (Matrix[int], error) { return ScaleChecked(-1, Matrix[int]{{math.MinInt}}) },
+		},
+		{
+			name: "MultiplyChecked",
+			fn: func() (Matrix[int], error) {
+				return MultiplyChecked(Matrix[int]{{1 << 31, 1}}, Matrix[int]{{1 << 31}, {-1}})
+			},
+			want: Matrix[int]{{1<<62 - 1}},
+		},
+		{
+			name: "MultiplyChecked product overflow",
+			fn:   func() (Matrix[int], error) { return MultiplyChecked(Matrix[int]{{1 << 32}}, Matrix[int]{{1 << 32}}) },
+		},
+		{
+			name: "MultiplyChecked sum overflow",
+			fn: func() (Matrix[int], error) {
+				return MultiplyChecked(Matrix[int]{{1 << 62, 1 << 62}}, Matrix[int]{{1}, {1}})
+			},
+		},
+		{
+			// [[1, 1], [1, 0]]ⁿ holds Fₙ₊₁, the largest fitting in an int64 being F₉₂
+			name: "PowChecked F₉₁",
+			fn: func() (Matrix[int], error) {
+				p, err := PowChecked(Matrix[int]{{1, 1}, {1, 0}}, 91)
+				if err != nil {
+					return nil, err
+				}
+				return Matrix[int]{{p[0][1]}}, nil
+			},
+			want: Matrix[int]{{4660046610375530309}},
+		},
+		{
+			name: "PowChecked F₉₃ overflow",
+			fn:   func() (Matrix[int], error) { return PowChecked(Matrix[int]{{1, 1}, {1, 0}}, 92) },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.fn()
+			if tt.want == nil {
+				if !errors.Is(err, ErrOverflow) {
+					t.Errorf("expected ErrOverflow, got %v (result %v)", err, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !intMatricesEqual(got, tt.want) {
+				t.Errorf("got %v, expected %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMulInt(t *testing.T) {
+	tests := []struct {
+		x, y int
+		ok   bool
+	}{
+		{0, math.MinInt, true},
+		{-1, math.MaxInt, true},
+		{-1, math.MinInt, false},
+		{math.MinInt, -1, false},
+		{math.MinInt, 1, true},
+		{1 << 31, 1 << 31, true},
+		{1 << 32, 1 << 31, false},
+		{-(1 << 32), 1 << 31, true},
+		{3, math.MaxInt / 2, false},
+	}
+
+	for _, tt := range tests {
+		p, ok := mulInt(tt.x, tt.y)
+		if ok != tt.ok {
+			t.Errorf("mulInt(%d, %d) ok = %v, expected %v", tt.x, tt.y, ok, tt.ok)
+		}
+		if ok && p != tt.x*tt.y {
+			t.Errorf("mulInt(%d, %d) = %d", tt.x, tt.y, p)
+		}
+	}
+}
+
+func TestSameType_Errors(t *testing.T) {
+	a := Matrix[int]{{1, 2, 3}}
+
+	if _, err := AddSame(a, Matrix[int]{{1, 2}}); !errors.Is(err, ErrDimensionMismatch) {
+		t.Errorf("AddSame: expected ErrDimensionMismatch, got %v", err)
+	}
+	if _, err := SubtractChecked(Matrix[int]{}, a); !errors.Is(err, ErrEmpty) {
+		t.Errorf("SubtractChecked: expected ErrEmpty, got %v", err)
+	}
+	var de *DimensionError
+	if _, err := MultiplySame(a, a); !errors.As(err, &de) || de.Op != "MultiplySame" {
+		t.Errorf("MultiplySame: expected *DimensionError, got %v", err)
+	}
+	if _, err := MultiplyChecked(Matrix[int]{{1, 2}, {3}}, a); err == nil {
+		t.Error("MultiplyChecked: expected error for improper matrix")
+	}
+	if _, err := ScaleChecked(2, Matrix[int]{}); !errors.Is(err, ErrEmpty) {
+		t.Errorf("ScaleChecked: expected ErrEmpty, got %v", err)
+	}
+	if got := ScaleSame(2, Matrix[int]{{1, 2}, {3}}); len(got) != 0 {
+		t.Errorf("ScaleSame() of an improper matrix = %v, expected an empty matrix", got)
+	}
+	if _, err := PowSame(a, 2); !errors.Is(err, ErrNotSquare) {
+		t.Errorf("PowSame: expected ErrNotSquare, got %v", err)
+	}
+	if _, err := PowChecked(Matrix[int]{{2}}, -1); !errors.Is(err, ErrInvalidArgument) {
+		t.Errorf("PowChecked: expected ErrInvalidArgument for a negative power, got %v", err)
+	}
+	if _, err := PowSame(Matrix[int]{{2}}, -1); !errors.Is(err, ErrInvalidArgument) {
+		t.Errorf("PowSame: expected ErrInvalidArgument for a negative power, got %v", err)
+	}
+}
+
+func BenchmarkMultiplySame(b *testing.B) {
+	sizes := []int{10, 50, 100}
+
+	for _, size := range sizes {
+		b.Run(fmt.Sprintf("%dx%d", size, size), func(b *testing.B) {
+			A := randomIntMatrix(size, size)
+			B := randomIntMatrix(size, size)
+			b.ResetTimer()
+			for b.Loop() {
+				_, _ = MultiplySame(A, B)
+			}
+		})
+	}
+}
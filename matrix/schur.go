@@ -0,0 +1,525 @@
+package matrix
+
+import (
+	"fmt"
+	"math"
+)
+
+// francisMaxIter bounds the number of Francis double-shift steps spent on a
+// single eigenvalue before the computation is declared to have failed.
+const francisMaxIter = 100
+
+// Schur computes the real Schur decomposition of a square matrix.
+//
+// Parameters:
+//   - m: Input matrix of type Matrix[T] where T is int or float64
+//
+// Returns:
+//   - Matrix[float64]: Orthogonal matrix Q whose columns are the Schur vectors
+//   - Matrix[float64]: Quasi upper triangular matrix T with A = Q·T·Qᵀ
+//   - error: Returns error if the matrix is invalid, empty, non-square, or if
+//     the iteration fails to converge
+//
+// T is upper triangular except for 2×2 blocks on its diagonal. Each 1×1
+// block is a real eigenvalue of A and each 2×2 block has a complex conjugate
+// pair of eigenvalues; everything below the blocks is exactly zero. The
+// leading k columns of Q span an invariant subspace of A whenever T has no
+// 2×2 block straddling rows k and k+1. The eigenvalues appear in no
+// particular order; use ReorderSchur to move selected ones to the top.
+//
+// The matrix is reduced to Hessenberg form and then iterated with the Francis
+// double-shift QR algorithm, as in Eigenvalues, while accumulating the
+// transformations.
+//
+// Time complexity: O(n³) where n is the matrix dimension.
+func Schur[T int | float64](m Matrix[T]) (Matrix[float64], Matrix[float64], error) {
+	if err := m.Validate(); err != nil {
+		return nil, nil, err
+	}
+
+	if len(m) == 0 {
+		return nil, nil, ErrEmpty
+	}
+	if !m.isSquare() {
+		return nil, nil, ErrNotSquare
+	}
+
+	t := gtoFloat64Matrix(m)
+	q := hessenbergReduce(t, true)
+	if _, err := francisQR(t, q); err != nil {
+		return nil, nil, err
+	}
+	return q, t, nil
+}
+
+// ReorderSchur reorders a real Schur decomposition so that the selected
+// eigenvalues appear in the leading diagonal blocks of T.
+//
+// Parameters:
+//   - q: Orthogonal factor of the decomposition, as returned by Schur
+//   - t: Quasi upper triangular factor of the decomposition, as returned by Schur
+//   - sel: Reports whether an eigenvalue should be moved to the top
+//
+// Returns:
+//   - Matrix[float64]: Updated orthogonal factor Q'
+//   - Matrix[float64]: Updated quasi upper triangular factor T' with Q'·T'·Q'ᵀ = Q·T·Qᵀ
+//   - error: Returns error if the inputs are invalid, have mismatched sizes
+//     (ErrDimensionMismatch), t is not quasi upper triangular
+//     (ErrInvalidArgument), or two blocks that must be exchanged have (nearly)
+//     equal eigenvalues (ErrSingular)
+//
+// A complex conjugate pair is moved as a whole and is selected if sel
+// reports true for either of its members. Selected and unselected blocks
+// each keep their relative order. Afterwards the leading columns of Q' span
+// the invariant subspace belonging to the selected eigenvalues, which is how
+// stable or unstable subspaces are extracted, for example when solving
+// algebraic Riccati equations.
+//
+// Blocks are moved by exchanging neighbouring pairs with orthogonal
+// transformations. The inputs are not modified.
+//
+// Example:
+//
+//	Q, T, _ := Schur(A)
+//	stable := func(λ complex128) bool { return real(λ) < 0 }
+//	Q, T, _ = ReorderSchur(Q, T, stable)  // Leading columns of Q span the stable subspace
+//
+// Time complexity: O(n²) per exchange, O(n³) in the worst case.
+func ReorderSchur(q, t Matrix[float64], sel func(complex128) bool) (Matrix[float64], Matrix[float64], error) {
+	if err := q.Validate(); err != nil {
+		return nil, nil, err
+	}
+	if err := t.Validate(); err != nil {
+		return nil, nil, err
+	}
+
+	n := len(t)
+	if n == 0 {
+		return nil, nil, ErrEmpty
+	}
+	if !t.isSquare() || !q.isSquare() {
+		return nil, nil, ErrNotSquare
+	}
+	if len(q) != n {
+		return nil, nil, dimensionError("ReorderSchur", q.shape(), t.shape())
+	}
+	if !isQuasiTriangular(t) {
+		return nil, nil, fmt.Errorf("matrix is not in real Schur form: %w", ErrInvalidArgument)
+	}
+
+	q, t = cloneMatrix(q), cloneMatrix(t)
+	top := 0 // Start of the first block after the selected ones
+	for k := 0; k < n; {
+		size := schurBlockSize(t, k)
+		if !sel(schurBlockEigenvalue(t, k, size)) {
+			k += size
+			continue
+		}
+
+		// Bubble the block up past the unselected blocks above it
+		for pos := k; pos > top; {
+			prev := pos - 1
+			if prev > 0 && t[prev][prev-1] != 0 {
+				prev--
+			}
+			if err := swapSchurBlocks(q, t, prev, pos-prev, size); err != nil {
+				return nil, nil, err
+			}
+			pos = prev
+		}
+		top += size
+		k += size
+	}
+
+	return q, t, nil
+}
+
+// isQuasiTriangular reports whether t is zero below its first subdiagonal and
+// has no two consecutive nonzero subdiagonal entries.
+func isQuasiTriangular(t Matrix[float64]) bool {
+	for i := 1; i < len(t); i++ {
+		for j := range i - 1 {
+			if t[i][j] != 0 {
+				return false
+			}
+		}
+		if i > 1 && t[i][i-1] != 0 && t[i-1][i-2] != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// schurBlockSize returns the size (1 or 2) of the diagonal block of the
+// quasi triangular matrix t that starts at row k.
+func schurBlockSize(t Matrix[float64], k int) int {
+	if k+1 < len(t) && t[k+1][k] != 0 {
+		return 2
+	}
+	return 1
+}
+
+// schurBlockEigenvalue returns the eigenvalue of the diagonal block of t
+// starting at row k, or for a 2×2 block the member of its conjugate pair
+// with positive imaginary part.
+func schurBlockEigenvalue(t Matrix[float64], k, size int) complex128 {
+	if size == 1 {
+		return complex(t[k][k], 0)
+	}
+
+	a, b, c, d := t[k][k], t[k][k+1], t[k+1][k], t[k+1][k+1]
+	p := (a - d) / 2
+	disc := p*p + b*c
+	if disc >= 0 {
+		// Not a genuine complex pair; report the larger real eigenvalue
+		return complex((a+d)/2+math.Sqrt(disc), 0)
+	}
+	return complex((a+d)/2, math.Sqrt(-disc))
+}
+
+// swapSchurBlocks exchanges the adjacent diagonal blocks of t of sizes p and
+// r that start at row j, updating the Schur vectors q to match.
+//
+// The p×r solution X of the Sylvester equation T₁₁·X - X·T₂₂ = T₁₂ gives the
+// invariant subspace [-X; I] of the second block. An orthogonal basis for it,
+// taken from a QR factorization, moves that block to the front.
+func swapSchurBlocks(q, t Matrix[float64], j, p, r int) error {
+	n := len(t)
+	size := p + r
+
+	// Kronecker form of the Sylvester equation with vec(X) stored column-major
+	k, _ := NewEmptyMatrix(p*r, p*r)
+	rhs := make([]float64, p*r)
+	for c := range r {
+		for i := range p {
+			row := i + c*p
+			rhs[row] = t[j+i][j+p+c]
+			for i2 := range p {
+				k[row][i2+c*p] += t[j+i][j+i2]
+			}
+			for c2 := range r {
+				k[row][i+c2*p] -= t[j+p+c2][j+p+c]
+			}
+		}
+	}
+	// The Kronecker matrix is singular when the blocks share an eigenvalue;
+	// judge that relative to the blocks, not to the (possibly tiny) matrix itself
+	scale := 0.0
+	for i := j; i < j+size; i++ {
+		for _, v := range t[i][j : j+size] {
+			scale = max(scale, math.Abs(v))
+		}
+	}
+	lu, _ := NewLU(k, Tolerance{Abs: epsTol(size) * scale})
+	x, err := lu.SolveVector(rhs)
+	if err != nil {
+		return fmt.Errorf("cannot reorder Schur form: eigenvalues are too close: %w", err)
+	}
+
+	// Orthogonal basis for the invariant subspace [-X; I]
+	basis, _ := NewEmptyMatrix(size, r)
+	for c := range r {
+		for i := range p {
+			basis[i][c] = -x[i+c*p]
+		}
+		basis[p+c][c] = 1
+	}
+	u := newHouseholder(basis).q(size)
+
+	// T = Uᵀ·T·U on the affected rows and columns, Q = Q·U
+	tmp := make([]float64, size)
+	for col := j; col < n; col++ {
+		for a := range size {
+			s := 0.0
+			for b := range size {
+				s += u[b][a] * t[j+b][col]
+			}
+			tmp[a] = s
+		}
+		for a := range size {
+			t[j+a][col] = tmp[a]
+		}
+	}
+	for _, m := range []Matrix[float64]{t[:j+size], q} {
+		for row := range m {
+			for a := range size {
+				s := 0.0
+				for b := range size {
+					s += m[row][j+b] * u[b][a]
+				}
+				tmp[a] = s
+			}
+			copy(m[row][j:j+size], tmp)
+		}
+	}
+
+	// The block below the new leading block is zero up to rounding
+	for a := r; a < size; a++ {
+		for b := range r {
+			t[j+a][j+b] = 0
+		}
+	}
+	return nil
+}
+
+// francisQR reduces the upper Hessenberg matrix h to real Schur form in place
+// with the Francis implicit double-shift QR algorithm, following the EISPACK
+// routine HQR2.
+//
+// On return h is quasi upper triangular: 1×1 diagonal blocks hold real
+// eigenvalues and 2×2 blocks hold complex conjugate pairs, with zeros below
+// the blocks. If z is not nil the orthogonal transformations are accumulated
+// into it, so that passing the Q of the Hessenberg reduction yields the Schur
+// vectors. The eigenvalues are returned in diagonal order.
+func francisQR(h, z Matrix[float64]) ([]complex128, error) {
+	nn := len(h)
+	vals := make([]complex128, nn)
+
+	norm := 0.0
+	for i := range nn {
+		for j := max(i-1, 0); j < nn; j++ {
+			norm += math.Abs(h[i][j])
+		}
+	}
+
+	// rotate applies the plane rotation [q p; -p q] to rows and columns k and
+	// k+1, which splits a 2×2 block with real eigenvalues
+	rotate := func(k, n int, p, q float64) {
+		for j := k; j < nn; j++ {
+			t := h[k][j]
+			h[k][j] = q*t + p*h[k+1][j]
+			h[k+1][j] = q*h[k+1][j] - p*t
+		}
+		for i := 0; i <= n; i++ {
+			t := h[i][k]
+			h[i][k] = q*t + p*h[i][k+1]
+			h[i][k+1] = q*h[i][k+1] - p*t
+		}
+		for i := range z {
+			t := z[i][k]
+			z[i][k] = q*t + p*z[i][k+1]
+			z[i][k+1] = q*z[i][k+1] - p*t
+		}
+	}
+
+	exshift := 0.0
+	var p, q, r, s, w, x, y, zz float64
+	iter := 0
+	for n := nn - 1; n >= 0; {
+		// Look for a single small subdiagonal element
+		l := n
+		for l > 0 {
+			s = math.Abs(h[l-1][l-1]) + math.Abs(h[l][l])
+			if s == 0 {
+				s = norm
+			}
+			if h[l][l-1] == 0 || math.Abs(h[l][l-1]) < machEps*s {
+				break
+			}
+			l--
+		}
+
+		switch {
+		case l == n:
+			// One root found
+			if n > 0 {
+				h[n][n-1] = 0
+			}
+			h[n][n] += exshift
+			vals[n] = complex(h[n][n], 0)
+			n--
+			iter = 0
+
+		case l == n-1:
+			// Two roots found
+			if n > 1 {
+				h[n-1][n-2] = 0
+			}
+			w = h[n][n-1] * h[n-1][n]
+			p = (h[n-1][n-1] - h[n][n]) / 2
+			q = p*p + w
+			zz = math.Sqrt(math.Abs(q))
+			h[n][n] += exshift
+			h[n-1][n-1] += exshift
+			x = h[n][n]
+
+			if q >= 0 {
+				// Real pair: rotate the block to upper triangular form
+				if p >= 0 {
+					zz = p + zz
+				} else {
+					zz = p - zz
+				}
+				x = h[n][n-1]
+				s = math.Abs(x) + math.Abs(zz)
+				p, q = x/s, zz/s
+				r = math.Hypot(p, q)
+				rotate(n-1, n, p/r, q/r)
+				h[n][n-1] = 0
+				vals[n-1] = complex(h[n-1][n-1], 0)
+				vals[n] = complex(h[n][n], 0)
+			} else {
+				// Complex pair
+				vals[n-1] = complex(x+p, zz)
+				vals[n] = complex(x+p, -zz)
+			}
+			n -= 2
+			iter = 0
+
+		default:
+			// No convergence yet: form the shift
+			if iter == francisMaxIter {
+				return nil, fmt.Errorf("eigenvalue iteration: %w", ErrNoConvergence)
+			}
+
+			x = h[n][n]
+			y, w = 0, 0
+			if l < n {
+				y = h[n-1][n-1]
+				w = h[n][n-1] * h[n-1][n]
+			}
+
+			// Wilkinson's exceptional shift
+			if iter == 10 {
+				exshift += x
+				for i := 0; i <= n; i++ {
+					h[i][i] -= x
+				}
+				s = math.Abs(h[n][n-1]) + math.Abs(h[n-1][n-2])
+				x = 0.75 * s
+				y = x
+				w = -0.4375 * s * s
+			}
+
+			// A second exceptional shift, tried if the first did not help
+			if iter == 30 {
+				s = (y - x) / 2
+				s = s*s + w
+				if s > 0 {
+					s = math.Sqrt(s)
+					if y < x {
+						s = -s
+					}
+					s = x - w/((y-x)/2+s)
+					for i := 0; i <= n; i++ {
+						h[i][i] -= s
+					}
+					exshift += s
+					x, y, w = 0.964, 0.964, 0.964
+				}
+			}
+			iter++
+
+			// Look for two consecutive small subdiagonal elements
+			m := n - 2
+			for ; m >= l; m-- {
+				zz = h[m][m]
+				r = x - zz
+				s = y - zz
+				p = (r*s-w)/h[m+1][m] + h[m][m+1]
+				q = h[m+1][m+1] - zz - r - s
+				r = h[m+2][m+1]
+				s = math.Abs(p) + math.Abs(q) + math.Abs(r)
+				p /= s
+				q /= s
+				r /= s
+				if m == l {
+					break
+				}
+				if math.Abs(h[m][m-1])*(math.Abs(q)+math.Abs(r)) <
+					machEps*(math.Abs(p)*(math.Abs(h[m-1][m-1])+math.Abs(zz)+math.Abs(h[m+1][m+1]))) {
+					break
+				}
+			}
+
+			for i := m + 2; i <= n; i++ {
+				h[i][i-2] = 0
+				if i > m+2 {
+					h[i][i-3] = 0
+				}
+			}
+
+			// Double QR step involving rows l:n and columns m:n
+			for k := m; k <= n-1; k++ {
+				notLast := k != n-1
+				if k != m {
+					p = h[k][k-1]
+					q = h[k+1][k-1]
+					r = 0
+					if notLast {
+						r = h[k+2][k-1]
+					}
+					x = math.Abs(p) + math.Abs(q) + math.Abs(r)
+					if x == 0 {
+						continue
+					}
+					p /= x
+					q /= x
+					r /= x
+				}
+
+				s = math.Sqrt(p*p + q*q + r*r)
+				if p < 0 {
+					s = -s
+				}
+				if s == 0 {
+					continue
+				}
+				if k != m {
+					h[k][k-1] = -s * x
+				} else if l != m {
+					h[k][k-1] = -h[k][k-1]
+				}
+				p += s
+				x = p / s
+				y = q / s
+				zz = r / s
+				q /= p
+				r /= p
+
+				// Row modification
+				for j := k; j < nn; j++ {
+					p = h[k][j] + q*h[k+1][j]
+					if notLast {
+						p += r * h[k+2][j]
+						h[k+2][j] -= p * zz
+					}
+					h[k][j] -= p * x
+					h[k+1][j] -= p * y
+				}
+
+				// Column modification
+				for i := 0; i <= min(n, k+3); i++ {
+					p = x*h[i][k] + y*h[i][k+1]
+					if notLast {
+						p += zz * h[i][k+2]
+						h[i][k+2] -= p * r
+					}
+					h[i][k] -= p
+					h[i][k+1] -= p * q
+				}
+
+				// Accumulate transformations
+				for i := range z {
+					p = x*z[i][k] + y*z[i][k+1]
+					if notLast {
+						p += zz * z[i][k+2]
+						z[i][k+2] -= p * r
+					}
+					z[i][k] -= p
+					z[i][k+1] -= p * q
+				}
+			}
+		}
+	}
+
+	// The bulge chase leaves stale values below the subdiagonal that the
+	// iteration treats as zero
+	for i := 2; i < nn; i++ {
+		for j := range i - 1 {
+			h[i][j] = 0
+		}
+	}
+
+	return vals, nil
+}
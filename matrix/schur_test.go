@@ -0,0 +1,187 @@
+package matrix
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+)
+
+// checkSchur verifies that Q is orthogonal, T is quasi upper triangular and
+// Q·T·Qᵀ = A.
+func checkSchur(t *testing.T, A, Q, T Matrix[float64]) {
+	t.Helper()
+	n := len(A)
+
+	if !isQuasiTriangular(T) {
+		t.Fatalf("T is not quasi upper triangular\n%s", matrixToString(T))
+	}
+
+	QtQ, _ := Multiply(Transpose(Q), Q)
+	if !matricesAlmostEqual(QtQ, Identity(n), 1e-12) {
+		t.Errorf("QᵀQ != I\n%s", matrixToString(QtQ))
+	}
+
+	QT, _ := Multiply(Q, T)
+	QTQt, _ := Multiply(QT, Transpose(Q))
+	if !matricesAlmostEqual(QTQt, A, 1e-10) {
+		t.Errorf("Q·T·Qᵀ != A\n%s", matrixToString(QTQt))
+	}
+}
+
+func TestSchur(t *testing.T) {
+	tests := []struct {
+		name   string
+		matrix Matrix[float64]
+	}{
+		{name: "1x1 matrix", matrix: Matrix[float64]{{-2}}},
+		{name: "Rotation", matrix: Matrix[float64]{{0, -1}, {1, 0}}},
+		{name: "Real eigenvalues", matrix: Matrix[float64]{{4, 1}, {2, 3}}},
+		{name: "Companion matrix", matrix: Matrix[float64]{{1, -1, 1}, {1, 0, 0}, {0, 1, 0}}},
+		{name: "Zero matrix", matrix: Matrix[float64]{{0, 0, 0}, {0, 0, 0}, {0, 0, 0}}},
+		{name: "Random 8x8", matrix: randomFloatMatrix(8, 8)},
+		{name: "Random 50x50", matrix: randomFloatMatrix(50, 50)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			Q, T, err := Schur(tt.matrix)
+			if err != nil {
+				t.Fatalf("Schur() error = %v", err)
+			}
+			checkSchur(t, tt.matrix, Q, T)
+		})
+	}
+}
+
+func TestSchur_Errors(t *testing.T) {
+	if _, _, err := Schur(Matrix[int]{}); err == nil {
+		t.Error("expected error for empty matrix")
+	}
+	if _, _, err := Schur(Matrix[int]{{1, 2, 3}, {4, 5, 6}}); err == nil {
+		t.Error("expected error for non-square matrix")
+	}
+}
+
+func TestReorderSchur(t *testing.T) {
+	tests := []struct {
+		name   string
+		matrix Matrix[float64]
+		sel    func(complex128) bool
+	}{
+		{
+			name:   "Move complex pair to the top",
+			matrix: Matrix[float64]{{3, 1, 2}, {0, 0, -1}, {0, 1, 0}},
+			sel:    func(λ complex128) bool { return imag(λ) != 0 },
+		},
+		{
+			name:   "Stable eigenvalues of a random matrix",
+			matrix: randomShiftedMatrix(12, -5),
+			sel:    func(λ complex128) bool { return real(λ) < 0 },
+		},
+		{
+			name:   "Select nothing",
+			matrix: Matrix[float64]{{1, 2}, {3, 4}},
+			sel:    func(complex128) bool { return false },
+		},
+		{
+			name:   "Select everything",
+			matrix: randomFloatMatrix(6, 6),
+			sel:    func(complex128) bool { return true },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			Q, T, err := Schur(tt.matrix)
+			if err != nil {
+				t.Fatalf("Schur() error = %v", err)
+			}
+			Q2, T2, err := ReorderSchur(Q, T, tt.sel)
+			if err != nil {
+				t.Fatalf("ReorderSchur() error = %v", err)
+			}
+			checkSchur(t, tt.matrix, Q2, T2)
+
+			// Selected blocks come first, and the block structure is unchanged
+			n, blocks, selected := len(T2), 0, 0
+			seenUnselected := false
+			for k := 0; k < n; {
+				size := schurBlockSize(T2, k)
+				blocks++
+				if tt.sel(schurBlockEigenvalue(T2, k, size)) {
+					selected++
+					if seenUnselected {
+						t.Fatalf("selected block at row %d follows an unselected one\n%s", k, matrixToString(T2))
+					}
+				} else {
+					seenUnselected = true
+				}
+				k += size
+			}
+
+			want := 0
+			for k := 0; k < n; {
+				size := schurBlockSize(T, k)
+				if tt.sel(schurBlockEigenvalue(T, k, size)) {
+					want++
+				}
+				k += size
+			}
+			if selected != want {
+				t.Errorf("expected %d selected blocks, got %d", want, selected)
+			}
+		})
+	}
+}
+
+func TestReorderSchur_Errors(t *testing.T) {
+	sel := func(λ complex128) bool { return real(λ) > 1 }
+	I := Identity(2)
+
+	if _, _, err := ReorderSchur(Identity(3), Matrix[float64]{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}}, sel); !errors.Is(err, ErrInvalidArgument) {
+		t.Errorf("expected ErrInvalidArgument for T with a nonzero entry below the subdiagonal, got %v", err)
+	}
+	if _, _, err := ReorderSchur(I, Matrix[float64]{{1, 2}, {3, 4}, {5, 6}}, sel); err == nil {
+		t.Error("expected error for non-square T")
+	}
+	if _, _, err := ReorderSchur(Identity(3), Matrix[float64]{{1, 2}, {0, 4}}, sel); err == nil {
+		t.Error("expected error for mismatched sizes")
+	}
+	if _, _, err := ReorderSchur(
+		Identity(3),
+		Matrix[float64]{{1, 1, 0}, {1, 1, 0}, {0, 1, 1}},
+		sel,
+	); err == nil {
+		t.Error("expected error for consecutive nonzero subdiagonal entries")
+	}
+
+	// Exchanging blocks with nearly equal eigenvalues is ill-posed
+	T := Matrix[float64]{{1, 5}, {0, math.Nextafter(1, 2)}}
+	if _, _, err := ReorderSchur(I, T, sel); !errors.Is(err, ErrSingular) {
+		t.Errorf("expected ErrSingular for nearly equal eigenvalues, got %v", err)
+	}
+}
+
+// randomShiftedMatrix returns a random n×n matrix plus shift·I.
+func randomShiftedMatrix(n int, shift float64) Matrix[float64] {
+	A := randomFloatMatrix(n, n)
+	for i := range n {
+		A[i][i] += shift
+	}
+	return A
+}
+
+func BenchmarkSchur(b *testing.B) {
+	sizes := []int{10, 50, 100}
+
+	for _, size := range sizes {
+		b.Run(fmt.Sprintf("%dx%d", size, size), func(b *testing.B) {
+			A := randomFloatMatrix(size, size)
+			b.ResetTimer()
+			for b.Loop() {
+				_, _, _ = Schur(A)
+			}
+		})
+	}
+}
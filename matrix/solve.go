@@ -0,0 +1,202 @@
+package matrix
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/rickykimani/linalg/internal/negligible"
+	"github.com/rickykimani/linalg/vectors"
+)
+
+// Solve solves the linear system A·x = b.
+//
+// Parameters:
+//   - a: Coefficient matrix of type Matrix[T] where T is int or float64
+//   - b: Right-hand side vector of type Vector[E] where E is int or float64
+//   - tol: Optional tolerance for rank and conditioning decisions (default n·ε)
+//
+// Returns:
+//   - vectors.Vector[float64]: The solution vector x with one element per column of A
+//   - error: An error if the inputs are invalid or empty (ErrEmpty, or vectors.ErrEmpty
+//     for b), the dimensions do not match (*DimensionError), the matrix is singular
+//     (ErrSingular) or too badly conditioned for a meaningful solution
+//     (*ConditionError, matching ErrIllConditioned)
+//
+// The method is chosen from the shape of A:
+//   - Square: LU decomposition with partial pivoting
+//   - Overdetermined (more rows than columns): least squares via Householder QR,
+//     minimizing ‖A·x - b‖₂
+//   - Underdetermined (fewer rows than columns): minimum-norm solution via
+//     Householder QR of Aᵀ
+//
+// A pivot, or a diagonal element of the triangular QR factor, within the
+// tolerance relative to the norm of A makes the system singular. A system is
+// also rejected as ill-conditioned when its estimated reciprocal condition
+// number is within the tolerance: with the default n·ε, that is the point
+// beyond which the solution carries no correct digits. Rectangular systems
+// must have full rank; a rank-deficient system is reported as ErrSingular.
+// Use LeastSquares to get the minimum-norm solution instead.
+//
+// Solving directly is both faster and more accurate than computing Inverse and
+// then calling MultiplyVector.
+//
+// Example:
+//
+//	A := Matrix[int]{{2, 1}, {1, 3}}
+//	x, _ := Solve(A, vectors.Vector[int]{3, 5})  // Returns [0.8, 1.4]
+func Solve[T, E int | float64](a Matrix[T], b vectors.Vector[E], tol ...Tolerance) (vectors.Vector[float64], error) {
+	// Validate matrix structure
+	if err := a.Validate(); err != nil {
+		return nil, fmt.Errorf("matrix: %w", err)
+	}
+
+	// Handle empty inputs
+	if len(a) == 0 || len(a[0]) == 0 {
+		return nil, ErrEmpty
+	}
+	if len(b) == 0 {
+		return nil, vectors.ErrEmpty
+	}
+
+	// Check dimension compatibility
+	if len(a) != len(b) {
+		return nil, dimensionError("Solve", a.shape(), [2]int{len(b), 1})
+	}
+
+	x, err := solveColumns(gtoFloat64Matrix(a), [][]float64{b.Copy()}, tol)
+	if err != nil {
+		return nil, err
+	}
+	return x[0], nil
+}
+
+// SolveMatrix solves the linear system A·X = B, treating every column of B as
+// a separate right-hand side.
+//
+// Parameters:
+//   - a: Coefficient matrix of type Matrix[T] where T is int or float64
+//   - b: Right-hand side matrix of type Matrix[E] where E is int or float64
+//   - tol: Optional tolerance, as in Solve
+//
+// Returns:
+//   - Matrix[float64]: The solution matrix X with one row per column of A and one
+//     column per column of B
+//   - error: The same errors as Solve
+//
+// The method is chosen from the shape of A exactly as in Solve. The matrix is
+// factorized only once, no matter how many columns B has.
+func SolveMatrix[T, E int | float64](a Matrix[T], b Matrix[E], tol ...Tolerance) (Matrix[float64], error) {
+	// Validate matrix structure
+	if err := a.Validate(); err != nil {
+		return nil, fmt.Errorf("first matrix: %w", err)
+	}
+	if err := b.Validate(); err != nil {
+		return nil, fmt.Errorf("second matrix: %w", err)
+	}
+
+	// Handle empty matrices
+	if len(a) == 0 || len(a[0]) == 0 || len(b) == 0 {
+		return nil, ErrEmpty
+	}
+
+	// Check dimension compatibility
+	if len(a) != len(b) {
+		return nil, dimensionError("SolveMatrix", a.shape(), b.shape())
+	}
+
+	// Rows of Bᵀ are the right-hand side columns
+	cols, err := solveColumns(gtoFloat64Matrix(a), Transpose(gtoFloat64Matrix(b)), tol)
+	if err != nil {
+		return nil, err
+	}
+	return Transpose(Matrix[float64](cols)), nil
+}
+
+// solveColumns solves A·x = b for every right-hand side in rhs, each of which
+// has one element per row of a. The matrix a may be overwritten.
+func solveColumns(a Matrix[float64], rhs [][]float64, tol []Tolerance) ([][]float64, error) {
+	rows, cols := len(a), len(a[0])
+	n := max(rows, cols)
+	limit := negligible.Threshold(tol, epsTol(n), 1) // For the reciprocal condition number
+	result := make([][]float64, len(rhs))
+
+	switch {
+	case rows == cols:
+		f, _ := NewLU(a, tol...) // Shape already validated
+		if f.singular {
+			return nil, ErrSingular
+		}
+
+		if cond := f.CondEst(); cond*limit > 1 {
+			return nil, &ConditionError{Cond: cond}
+		}
+
+		for c, b := range rhs {
+			result[c], _ = f.SolveVector(b)
+		}
+
+	case rows > cols:
+		// Least squares: x = R⁻¹·(Qᵀ·b)[:cols]
+		anorm := frobenius(a)
+		h := newHouseholder(a)
+		if err := checkFullRank(h, negligible.Threshold(tol, epsTol(n), anorm), limit); err != nil {
+			return nil, err
+		}
+
+		for c, b := range rhs {
+			y := make([]float64, rows)
+			copy(y, b)
+			h.applyQT(y)
+
+			x := y[:cols]
+			for i := cols - 1; i >= 0; i-- {
+				for k := i + 1; k < cols; k++ {
+					x[i] -= h.qr[i][k] * x[k]
+				}
+				x[i] /= h.rdiag[i]
+			}
+			result[c] = x
+		}
+
+	default:
+		// Minimum norm: with Aᵀ = Q·R, x = Q·[R⁻ᵀ·b; 0]
+		anorm := frobenius(a)
+		h := newHouseholder(Transpose(a))
+		if err := checkFullRank(h, negligible.Threshold(tol, epsTol(n), anorm), limit); err != nil {
+			return nil, err
+		}
+
+		for c, b := range rhs {
+			x := make([]float64, cols)
+			for i := range rows {
+				x[i] = b[i]
+				for k := range i {
+					x[i] -= h.qr[k][i] * x[k]
+				}
+				x[i] /= h.rdiag[i]
+			}
+			h.applyQ(x)
+			result[c] = x
+		}
+	}
+
+	return result, nil
+}
+
+// checkFullRank reports whether the triangular factor of h is usable for a
+// least squares or minimum-norm solve. A diagonal entry no larger than zeroTol
+// means the matrix is rank deficient, and a reciprocal condition estimate
+// below limit that it is ill-conditioned.
+func checkFullRank(h *householder, zeroTol, limit float64) error {
+	for _, d := range h.rdiag {
+		if math.Abs(d) <= zeroTol {
+			return fmt.Errorf("matrix is rank deficient: %w", ErrSingular)
+		}
+	}
+
+	rc := h.rcondDiag()
+	if rc < limit {
+		return &ConditionError{Cond: 1 / rc}
+	}
+	return nil
+}
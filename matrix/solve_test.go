@@ -0,0 +1,227 @@
+package matrix
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/rickykimani/linalg/vectors"
+)
+
+func TestSolve(t *testing.T) {
+	tests := []struct {
+		name string
+		a    Matrix[float64]
+		b    vectors.Vector[float64]
+		want vectors.Vector[float64]
+	}{
+		{
+			name: "square system",
+			a: Matrix[float64]{
+				{2, 1},
+				{1, 3},
+			},
+			b:    vectors.Vector[float64]{3, 5},
+			want: vectors.Vector[float64]{0.8, 1.4},
+		},
+		{
+			name: "square system requiring pivoting",
+			a: Matrix[float64]{
+				{0, 1, 2},
+				{1, 2, 3},
+				{2, 3, 5},
+			},
+			b:    vectors.Vector[float64]{8, 14, 23},
+			want: vectors.Vector[float64]{1, 2, 3},
+		},
+		{
+			name: "overdetermined consistent system",
+			a: Matrix[float64]{
+				{1, 0},
+				{0, 1},
+				{1, 1},
+			},
+			b:    vectors.Vector[float64]{1, 2, 3},
+			want: vectors.Vector[float64]{1, 2},
+		},
+		{
+			name: "overdetermined line fit",
+			a: Matrix[float64]{
+				{1, 0},
+				{1, 1},
+				{1, 2},
+			},
+			b:    vectors.Vector[float64]{1, 2, 2},
+			want: vectors.Vector[float64]{7.0 / 6, 0.5},
+		},
+		{
+			name: "underdetermined minimum norm",
+			a: Matrix[float64]{
+				{1, 1},
+			},
+			b:    vectors.Vector[float64]{2},
+			want: vectors.Vector[float64]{1, 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			x, err := Solve(tt.a, tt.b)
+			if err != nil {
+				t.Fatalf("Solve() error = %v", err)
+			}
+			if len(x) != len(tt.want) {
+				t.Fatalf("Solve() returned %d elements, want %d", len(x), len(tt.want))
+			}
+			for i := range x {
+				if math.Abs(x[i]-tt.want[i]) > 1e-10 {
+					t.Errorf("x[%d] = %v, want %v", i, x[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestSolve_Errors(t *testing.T) {
+	t.Run("singular square", func(t *testing.T) {
+		A := Matrix[int]{
+			{1, 2},
+			{2, 4},
+		}
+		_, err := Solve(A, vectors.Vector[int]{1, 2})
+		if !errors.Is(err, ErrSingular) {
+			t.Errorf("expected ErrSingular, got %v", err)
+		}
+	})
+
+	t.Run("rank deficient rectangular", func(t *testing.T) {
+		A := Matrix[int]{
+			{1, 2},
+			{2, 4},
+			{3, 6},
+		}
+		_, err := Solve(A, vectors.Vector[int]{1, 2, 3})
+		if !errors.Is(err, ErrSingular) {
+			t.Errorf("expected ErrSingular, got %v", err)
+		}
+	})
+
+	t.Run("nearly singular relative to its norm", func(t *testing.T) {
+		// Rows differ by a single ulp: the last pivot is about 1e-4 in absolute
+		// terms but within rounding error of ‖A‖ ≈ 2·10¹²
+		A := Matrix[float64]{
+			{1e12, 1e12},
+			{1e12, math.Nextafter(1e12, 2e12)},
+		}
+		_, err := Solve(A, vectors.Vector[float64]{1, 2})
+		if !errors.Is(err, ErrSingular) {
+			t.Errorf("expected ErrSingular, got %v", err)
+		}
+	})
+
+	t.Run("ill-conditioned", func(t *testing.T) {
+		// Every pivot is 1, far from zero, but the condition number is
+		// around 10²⁷
+		A := Matrix[float64]{
+			{1, 1e9, 0},
+			{0, 1, 1e9},
+			{0, 0, 1},
+		}
+		_, err := Solve(A, vectors.Vector[float64]{1, 2, 3})
+		var condErr *ConditionError
+		if !errors.As(err, &condErr) || !errors.Is(err, ErrIllConditioned) {
+			t.Fatalf("expected *ConditionError, got %v", err)
+		}
+		if condErr.Cond < 1e15 {
+			t.Errorf("condition estimate = %g, expected a huge value", condErr.Cond)
+		}
+	})
+
+	t.Run("dimension mismatch", func(t *testing.T) {
+		A := Matrix[int]{
+			{1, 0},
+			{0, 1},
+		}
+		if _, err := Solve(A, vectors.Vector[int]{1, 2, 3}); err == nil {
+			t.Error("expected error for mismatched dimensions")
+		}
+	})
+
+	t.Run("empty inputs", func(t *testing.T) {
+		if _, err := Solve(Matrix[int]{}, vectors.Vector[int]{1}); err == nil {
+			t.Error("expected error for empty matrix")
+		}
+		if _, err := Solve(Matrix[int]{{1}}, vectors.Vector[int]{}); err == nil {
+			t.Error("expected error for empty vector")
+		}
+		// Rows without columns are as empty as no rows at all
+		if _, err := Solve(Matrix[int]{{}}, vectors.Vector[int]{1}); !errors.Is(err, ErrEmpty) {
+			t.Errorf("expected ErrEmpty for a matrix without columns, got %v", err)
+		}
+		if _, err := SolveMatrix(Matrix[int]{{}, {}}, Matrix[int]{{1}, {2}}); !errors.Is(err, ErrEmpty) {
+			t.Errorf("SolveMatrix: expected ErrEmpty for a matrix without columns, got %v", err)
+		}
+	})
+}
+
+func TestSolveMatrix(t *testing.T) {
+	A := Matrix[int]{
+		{4, 3},
+		{6, 3},
+	}
+	B := Matrix[int]{
+		{1, 0, 7},
+		{0, 1, 9},
+	}
+
+	X, err := SolveMatrix(A, B)
+	if err != nil {
+		t.Fatalf("SolveMatrix() error = %v", err)
+	}
+	if X.Rows() != 2 || X.Cols() != 3 {
+		t.Fatalf("SolveMatrix() returned %d×%d, want 2×3", X.Rows(), X.Cols())
+	}
+
+	AX, _ := Multiply(A, X)
+	if !matricesAlmostEqual(AX, toFloat64Matrix(B), 1e-12) {
+		t.Errorf("A·X != B\ngot:\n%s", matrixToString(AX))
+	}
+
+	// The first two columns form the inverse
+	inv, _ := Inverse(A)
+	for i := range 2 {
+		if !rowsAlmostEqual(X[i][:2], inv[i], 1e-12) {
+			t.Errorf("row %d of X = %v, want %v", i, X[i][:2], inv[i])
+		}
+	}
+
+	// Overdetermined with several right-hand sides
+	T := Matrix[float64]{
+		{1, 0},
+		{1, 1},
+		{1, 2},
+	}
+	Y, err := SolveMatrix(T, Matrix[float64]{{1, 0}, {2, 1}, {2, 2}})
+	if err != nil {
+		t.Fatalf("SolveMatrix() error = %v", err)
+	}
+	want := Matrix[float64]{
+		{7.0 / 6, 0},
+		{0.5, 1},
+	}
+	if !matricesAlmostEqual(Y, want, 1e-12) {
+		t.Errorf("SolveMatrix() = %v, want %v", Y, want)
+	}
+}
+
+func BenchmarkSolve100x100(b *testing.B) {
+	A := randomFloatMatrix(100, 100)
+	rhs := make(vectors.Vector[float64], 100)
+	for i := range rhs {
+		rhs[i] = float64(i)
+	}
+
+	for b.Loop() {
+		_, _ = Solve(A, rhs)
+	}
+}
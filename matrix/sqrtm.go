@@ -0,0 +1,185 @@
+package matrix
+
+import (
+	"fmt"
+	"math"
+)
+
+// Sqrt computes the principal square root of a matrix, the unique X with
+// X·X = A whose eigenvalues all have positive real part.
+//
+// Parameters:
+//   - m: Square input matrix of type Matrix[T] where T is int or float64
+//
+// Returns:
+//   - Matrix[float64]: The principal square root X
+//   - float64: Relative residual ‖X·X - A‖_F / ‖A‖_F, an estimate of the error
+//   - error: Returns error if the matrix is invalid, empty (ErrEmpty) or non-square
+//     (ErrNotSquare), if it has a negative real eigenvalue and therefore no real
+//     principal square root (ErrDomain), or if it is singular in a way that leaves
+//     it without any square root (ErrSingular)
+//
+// The real Schur method of Higham (1987) is used. With A = Q·T·Qᵀ, the square
+// root R of the quasi upper triangular T is built one block at a time: the
+// diagonal blocks directly and every block above them from a small Sylvester
+// equation. Then X = Q·R·Qᵀ. All arithmetic is real, even for complex
+// eigenvalues.
+//
+// A simple zero eigenvalue is allowed, but a repeated one, as in the nilpotent
+// [[0, 1], [0, 0]], generally has no square root at all.
+//
+// Example:
+//
+//	A := Matrix[int]{{33, 24}, {48, 57}}
+//	X, _, _ := Sqrt(A)  // Returns [[5, 2], [4, 7]]
+//
+// Time complexity: O(n³) where n is the matrix dimension.
+func Sqrt[T int | float64](m Matrix[T]) (Matrix[float64], float64, error) {
+	q, t, err := Schur(m)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	r, err := sqrtQuasiTriangular(t)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	qr, _ := Multiply(q, r)
+	x, _ := Multiply(qr, Transpose(q))
+
+	// Relative residual of X·X = A
+	a := gtoFloat64Matrix(m)
+	anorm := frobenius(a)
+	if anorm == 0 {
+		return x, 0, nil
+	}
+	x2, _ := Multiply(x, x)
+	res, _ := Subtract(x2, a)
+	return x, frobenius(res) / anorm, nil
+}
+
+// sqrtQuasiTriangular returns the principal square root of the quasi upper
+// triangular t, which has the same block structure as t.
+func sqrtQuasiTriangular(t Matrix[float64]) (Matrix[float64], error) {
+	n := len(t)
+	var starts []int
+	for k := 0; k < n; k += schurBlockSize(t, k) {
+		starts = append(starts, k)
+	}
+	zeroTol := epsTol(n) * frobenius(t)
+
+	// Diagonal blocks
+	r, _ := NewEmptyMatrix(n, n)
+	for _, k := range starts {
+		if schurBlockSize(t, k) == 1 {
+			v := t[k][k]
+			if v < 0 {
+				if -v > zeroTol {
+					return nil, fmt.Errorf("negative eigenvalue %g: %w", v, ErrDomain)
+				}
+				v = 0 // A zero eigenvalue perturbed by rounding
+			}
+			r[k][k] = math.Sqrt(v)
+			continue
+		}
+
+		// √M = (M + δI)/τ with δ = √det M and τ = √(tr M + 2δ)
+		a, b, c, d := t[k][k], t[k][k+1], t[k+1][k], t[k+1][k+1]
+		det := a*d - b*c
+		if det < 0 {
+			return nil, fmt.Errorf("real eigenvalues of opposite sign at row %d: %w", k, ErrDomain)
+		}
+		delta := math.Sqrt(det)
+		tau := a + d + 2*delta
+		if tau <= 0 {
+			return nil, fmt.Errorf("negative eigenvalues at row %d: %w", k, ErrDomain)
+		}
+		tau = math.Sqrt(tau)
+		r[k][k], r[k][k+1] = (a+delta)/tau, b/tau
+		r[k+1][k], r[k+1][k+1] = c/tau, (d+delta)/tau
+	}
+
+	// Blocks above the diagonal, column by column from the bottom up:
+	// R_ii·X + X·R_jj = T_ij - Σ R_ik·R_kj over the blocks k between i and j
+	for jb, j := range starts {
+		q := schurBlockSize(t, j)
+		for ib := jb - 1; ib >= 0; ib-- {
+			i := starts[ib]
+			p := schurBlockSize(t, i)
+
+			c, _ := NewEmptyMatrix(p, q)
+			for a := range p {
+				for b := range q {
+					s := t[i+a][j+b]
+					for k := i + p; k < j; k++ {
+						s -= r[i+a][k] * r[k][j+b]
+					}
+					c[a][b] = s
+				}
+			}
+
+			x, err := solveSmallSylvester(subBlock(r, i, p), subBlock(r, j, q), c, zeroTol)
+			if err != nil {
+				return nil, fmt.Errorf("repeated zero eigenvalue: %w", err)
+			}
+			for a := range p {
+				copy(r[i+a][j:j+q], x[a])
+			}
+		}
+	}
+	return r, nil
+}
+
+// subBlock returns a copy of the size×size diagonal block of t at row k.
+func subBlock(t Matrix[float64], k, size int) Matrix[float64] {
+	b := make(Matrix[float64], size)
+	for i := range size {
+		b[i] = append([]float64(nil), t[k+i][k:k+size]...)
+	}
+	return b
+}
+
+// solveSmallSylvester solves A·X + X·B = C for the p×q matrix X, where A is
+// p×p and B is q×q, through the pq×pq Kronecker form of the equation.
+//
+// The equation is singular when A and -B share an eigenvalue. It then still
+// has the solution X = 0 if C is zero within zeroTol; otherwise ErrSingular
+// is returned.
+func solveSmallSylvester(a, b, c Matrix[float64], zeroTol float64) (Matrix[float64], error) {
+	p, q := len(a), len(b)
+
+	// (I⊗A + Bᵀ⊗I)·vec(X) = vec(C), with vec(X) stored column-major
+	k, _ := NewEmptyMatrix(p*q, p*q)
+	rhs := make([]float64, p*q)
+	cnorm := 0.0
+	for col := range q {
+		for i := range p {
+			row := i + col*p
+			rhs[row] = c[i][col]
+			cnorm = max(cnorm, math.Abs(c[i][col]))
+			for i2 := range p {
+				k[row][i2+col*p] += a[i][i2]
+			}
+			for c2 := range q {
+				k[row][i+c2*p] += b[c2][col]
+			}
+		}
+	}
+
+	x, _ := NewEmptyMatrix(p, q)
+	lu, _ := NewLU(k, Tolerance{Abs: zeroTol})
+	v, err := lu.SolveVector(rhs)
+	if err != nil {
+		if cnorm <= zeroTol {
+			return x, nil
+		}
+		return nil, err
+	}
+	for col := range q {
+		for i := range p {
+			x[i][col] = v[i+col*p]
+		}
+	}
+	return x, nil
+}
@@ -0,0 +1,139 @@
+package matrix
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestSqrt(t *testing.T) {
+	tests := []struct {
+		name     string
+		matrix   Matrix[float64]
+		expected Matrix[float64] // nil to only check X·X = A
+	}{
+		{
+			name:     "Identity",
+			matrix:   Identity(3),
+			expected: Identity(3),
+		},
+		{
+			name:     "Zero matrix",
+			matrix:   Matrix[float64]{{0, 0}, {0, 0}},
+			expected: Matrix[float64]{{0, 0}, {0, 0}},
+		},
+		{
+			name:     "Real eigenvalues",
+			matrix:   Matrix[float64]{{33, 24}, {48, 57}},
+			expected: Matrix[float64]{{5, 2}, {4, 7}},
+		},
+		{
+			name:     "Upper triangular",
+			matrix:   Matrix[float64]{{4, 12}, {0, 9}},
+			expected: Matrix[float64]{{2, 2.4}, {0, 3}},
+		},
+		{
+			name:   "Complex eigenvalues",
+			matrix: Matrix[float64]{{1, -2, 0}, {2, 1, 1}, {0, 0, 4}},
+		},
+		{
+			name:   "Symmetric positive definite",
+			matrix: Matrix[float64]{{4, 1, 0}, {1, 3, 1}, {0, 1, 2}},
+		},
+		{
+			name:   "Simple zero eigenvalue",
+			matrix: Matrix[float64]{{1, 1}, {1, 1}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			X, est, err := Sqrt(tt.matrix)
+			if err != nil {
+				t.Fatalf("Sqrt() error = %v", err)
+			}
+			if tt.expected != nil && !matricesAlmostEqual(X, tt.expected, 1e-12) {
+				t.Errorf("expected\n%s\ngot\n%s", matrixToString(tt.expected), matrixToString(X))
+			}
+			X2, _ := Multiply(X, X)
+			if !matricesAlmostEqual(X2, tt.matrix, 1e-12) {
+				t.Errorf("X·X != A\n%s", matrixToString(X2))
+			}
+			if est > 1e-14 {
+				t.Errorf("error estimate %g is too large", est)
+			}
+			checkPrincipal(t, X)
+		})
+	}
+}
+
+func TestSqrt_Random(t *testing.T) {
+	for _, n := range []int{5, 20} {
+		t.Run(fmt.Sprintf("%dx%d", n, n), func(t *testing.T) {
+			// B has eigenvalues with positive real part, so B = √(B·B)
+			B := randomShiftedMatrix(n, 10*float64(n))
+			A, _ := Multiply(B, B)
+			X, est, err := Sqrt(A)
+			if err != nil {
+				t.Fatalf("Sqrt() error = %v", err)
+			}
+			if !matricesAlmostEqual(X, B, 1e-9*oneNorm(B)) {
+				t.Errorf("Sqrt(B·B) != B")
+			}
+			if est > 1e-12 {
+				t.Errorf("error estimate %g is too large", est)
+			}
+		})
+	}
+}
+
+func TestSqrt_Errors(t *testing.T) {
+	tests := []struct {
+		name   string
+		matrix Matrix[float64]
+		target error
+	}{
+		{"Empty", Matrix[float64]{}, ErrEmpty},
+		{"Non-square", Matrix[float64]{{1, 2, 3}, {4, 5, 6}}, ErrNotSquare},
+		{"Negative eigenvalue", Matrix[float64]{{-1, 0}, {0, 4}}, ErrDomain},
+		{"Negative eigenvalue pair", Matrix[float64]{{-2, 1}, {1, -2}}, ErrDomain},
+		{"Nilpotent", Matrix[float64]{{0, 1}, {0, 0}}, ErrSingular},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, _, err := Sqrt(tt.matrix); !errors.Is(err, tt.target) {
+				t.Errorf("expected %v, got %v", tt.target, err)
+			}
+		})
+	}
+}
+
+// checkPrincipal verifies that no eigenvalue of x has a negative real part.
+func checkPrincipal(t *testing.T, x Matrix[float64]) {
+	t.Helper()
+	vals, err := Eigenvalues(x)
+	if err != nil {
+		t.Fatalf("Eigenvalues() error = %v", err)
+	}
+	for _, λ := range vals {
+		if real(λ) < -1e-12 {
+			t.Errorf("eigenvalue %v of the square root has a negative real part", λ)
+		}
+	}
+}
+
+func BenchmarkSqrt(b *testing.B) {
+	sizes := []int{10, 50, 100}
+
+	for _, size := range sizes {
+		b.Run(fmt.Sprintf("%dx%d", size, size), func(b *testing.B) {
+			B := randomShiftedMatrix(size, 10*float64(size))
+			A, _ := Multiply(B, B)
+			b.ResetTimer()
+			for b.Loop() {
+				_, _, _ = Sqrt(A)
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package matrix
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+// tridiag returns the n×n matrix tridiag(-1, d, -1).
+func tridiag(n int, d float64) Matrix[float64] {
+	m, _ := NewEmptyMatrix(n, n)
+	for i := range n {
+		m[i][i] = d
+		if i > 0 {
+			m[i][i-1] = -1
+			m[i-1][i] = -1
+		}
+	}
+	return m
+}
+
+func TestIsDiagonallyDominant(t *testing.T) {
+	tests := []struct {
+		name     string
+		matrix   Matrix[int]
+		expected bool
+	}{
+		{"strictly dominant", Matrix[int]{{3, -1, 1}, {1, -4, 2}, {0, 1, 2}}, true},
+		{"weakly dominant row", Matrix[int]{{2, -1, 0}, {-1, 2, -1}, {0, -1, 2}}, false},
+		{"not dominant", Matrix[int]{{1, 2}, {3, 4}}, false},
+		{"1x1", Matrix[int]{{5}}, true},
+		{"zero 1x1", Matrix[int]{{0}}, false},
+		{"non-square", Matrix[int]{{3, 1, 1}}, false},
+		{"empty", Matrix[int]{}, false},
+		{"jagged", Matrix[int]{{3, 1}, {1}}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsDiagonallyDominant(tt.matrix); got != tt.expected {
+				t.Errorf("expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestOptimalOmega(t *testing.T) {
+	// For tridiag(-1, 2, -1) of size n, ρ = cos(π/(n+1))
+	for _, n := range []int{2, 5, 20} {
+		rho := math.Cos(math.Pi / float64(n+1))
+		want := 2 / (1 + math.Sqrt(1-rho*rho))
+		got, err := OptimalOmega(tridiag(n, 2))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if math.Abs(got-want) > 1e-10 {
+			t.Errorf("n = %d: expected %g, got %g", n, want, got)
+		}
+	}
+
+	// A diagonal matrix has ρ = 0 and ω = 1
+	if w, _ := OptimalOmega(Matrix[int]{{2, 0}, {0, 3}}); w != 1 {
+		t.Errorf("expected 1 for a diagonal matrix, got %g", w)
+	}
+
+	for name, tt := range map[string]struct {
+		m      Matrix[float64]
+		target error
+	}{
+		"empty":         {Matrix[float64]{}, ErrEmpty},
+		"non-square":    {Matrix[float64]{{1, 2}}, ErrNotSquare},
+		"zero diagonal": {Matrix[float64]{{0, 1}, {1, 1}}, ErrSingular},
+		"divergent":     {Matrix[float64]{{1, 2}, {2, 1}}, ErrNoConvergence},
+	} {
+		if _, err := OptimalOmega(tt.m); !errors.Is(err, tt.target) {
+			t.Errorf("%s: expected %v, got %v", name, tt.target, err)
+		}
+	}
+}
@@ -0,0 +1,288 @@
+package matrix
+
+import (
+	"fmt"
+
+	"github.com/rickykimani/linalg/internal/negligible"
+	"github.com/rickykimani/linalg/vectors"
+)
+
+// NullSpace computes an orthonormal basis for the null space of a matrix, the
+// vectors x with A·x = 0.
+//
+// Parameters:
+//   - m: Input matrix of type Matrix[T] where T is int or float64, of any shape m×n
+//   - tol: Optional tolerance; a singular value no larger than it, relative to
+//     the largest singular value σ₁, counts as zero (default max(m, n)·ε)
+//
+// Returns:
+//   - []vectors.Vector[float64]: n - r orthonormal vectors of length n, where r
+//     is the numerical rank; empty when A has full column rank
+//   - error: An error if the matrix is invalid or empty (ErrEmpty), or the SVD
+//     fails to converge (ErrNoConvergence)
+//
+// The basis is formed by the right singular vectors of the zero singular
+// values, the last n - r rows of Vᵀ in A = U·Σ·Vᵀ. Every solution of A·x = b
+// is a particular solution plus a combination of these vectors. Use
+// NullSpaceRREF for the textbook basis read off the reduced row echelon form.
+//
+// Example:
+//
+//	A := Matrix[int]{{1, 1}, {1, 1}}
+//	N, _ := NullSpace(A)  // [[-0.7071, 0.7071]], up to sign
+//
+// Time complexity: O(m·n·min(m, n)).
+func NullSpace[T int | float64](m Matrix[T], tol ...Tolerance) ([]vectors.Vector[float64], error) {
+	_, Vt, r, err := subspaceSVD(m, tol)
+	if err != nil {
+		return nil, err
+	}
+	return rowVectors(Vt, r, len(Vt)), nil
+}
+
+// ColumnSpace computes an orthonormal basis for the column space (range) of a
+// matrix, the vectors A·x for all x.
+//
+// Parameters:
+//   - m: Input matrix of type Matrix[T] where T is int or float64, of any shape m×n
+//   - tol: Optional tolerance, as for NullSpace
+//
+// Returns:
+//   - []vectors.Vector[float64]: r orthonormal vectors of length m, where r is
+//     the numerical rank
+//   - error: An error if the matrix is invalid or empty (ErrEmpty), or the SVD
+//     fails to converge (ErrNoConvergence)
+//
+// The basis is formed by the first r left singular vectors, the leading
+// columns of U in A = U·Σ·Vᵀ. Use ColumnSpaceRREF for a basis made of columns
+// of A itself.
+//
+// Time complexity: O(m·n·min(m, n)).
+func ColumnSpace[T int | float64](m Matrix[T], tol ...Tolerance) ([]vectors.Vector[float64], error) {
+	U, _, r, err := subspaceSVD(m, tol)
+	if err != nil {
+		return nil, err
+	}
+	return columnVectors(U, 0, r), nil
+}
+
+// RowSpace computes an orthonormal basis for the row space of a matrix, the
+// span of its rows, which is the orthogonal complement of the null space.
+//
+// Parameters:
+//   - m: Input matrix of type Matrix[T] where T is int or float64, of any shape m×n
+//   - tol: Optional tolerance, as for NullSpace
+//
+// Returns:
+//   - []vectors.Vector[float64]: r orthonormal vectors of length n, where r is
+//     the numerical rank
+//   - error: An error if the matrix is invalid or empty (ErrEmpty), or the SVD
+//     fails to converge (ErrNoConvergence)
+//
+// The basis is formed by the first r right singular vectors, the leading rows
+// of Vᵀ in A = U·Σ·Vᵀ. Use RowSpaceRREF for the nonzero rows of the reduced
+// row echelon form.
+//
+// Time complexity: O(m·n·min(m, n)).
+func RowSpace[T int | float64](m Matrix[T], tol ...Tolerance) ([]vectors.Vector[float64], error) {
+	_, Vt, r, err := subspaceSVD(m, tol)
+	if err != nil {
+		return nil, err
+	}
+	return rowVectors(Vt, 0, r), nil
+}
+
+// LeftNullSpace computes an orthonormal basis for the left null space of a
+// matrix, the vectors y with yᵀ·A = 0, which is the orthogonal complement of
+// the column space.
+//
+// Parameters:
+//   - m: Input matrix of type Matrix[T] where T is int or float64, of any shape m×n
+//   - tol: Optional tolerance, as for NullSpace
+//
+// Returns:
+//   - []vectors.Vector[float64]: m - r orthonormal vectors of length m, where r
+//     is the numerical rank; empty when A has full row rank
+//   - error: An error if the matrix is invalid or empty (ErrEmpty), or the SVD
+//     fails to converge (ErrNoConvergence)
+//
+// The basis is formed by the last m - r columns of U in the full SVD
+// A = U·Σ·Vᵀ. A system A·x = b is consistent exactly when b is orthogonal to
+// every one of these vectors.
+//
+// Time complexity: O(m·n·min(m, n)).
+func LeftNullSpace[T int | float64](m Matrix[T], tol ...Tolerance) ([]vectors.Vector[float64], error) {
+	U, _, r, err := subspaceSVD(m, tol)
+	if err != nil {
+		return nil, err
+	}
+	return columnVectors(U, r, len(U)), nil
+}
+
+// NullSpaceRREF computes the textbook basis for the null space of a matrix,
+// read off its reduced row echelon form.
+//
+// Parameters:
+//   - m: Input matrix of type Matrix[T] where T is int or float64, of any shape m×n
+//   - tol: Optional tolerance for the pivots, as for RREF
+//
+// Returns:
+//   - []vectors.Vector[float64]: One vector of length n per free (non-pivot)
+//     column; empty when every column has a pivot
+//   - error: An error if the matrix is invalid or empty (ErrEmpty)
+//
+// The vector for free column f has a 1 in position f, a 0 in every other free
+// position, and minus the entries of column f of the reduced row echelon form
+// in the pivot positions. This is the basis of the "special solutions" found by
+// hand, with simple entries for simple matrices, but unlike NullSpace it is
+// neither orthogonal nor normalized, and it is less reliable for matrices that
+// are close to rank deficient.
+//
+// Example:
+//
+//	A := Matrix[int]{{1, 2, 1}, {2, 4, 0}}
+//	N, _ := NullSpaceRREF(A)  // [[-2, 1, 0]]
+func NullSpaceRREF[T int | float64](m Matrix[T], tol ...Tolerance) ([]vectors.Vector[float64], error) {
+	R, pivots, err := RREF(m, tol...)
+	if err != nil {
+		return nil, err
+	}
+	return specialSolutions(R, pivots), nil
+}
+
+// ColumnSpaceRREF computes a basis for the column space of a matrix made of
+// its pivot columns.
+//
+// Parameters:
+//   - m: Input matrix of type Matrix[T] where T is int or float64, of any shape m×n
+//   - tol: Optional tolerance for the pivots, as for RREF
+//
+// Returns:
+//   - []vectors.Vector[float64]: The columns of A, unchanged, at the pivot
+//     columns of its reduced row echelon form
+//   - error: An error if the matrix is invalid or empty (ErrEmpty)
+//
+// Example:
+//
+//	A := Matrix[int]{{1, 2, 1}, {2, 4, 0}}
+//	C, _ := ColumnSpaceRREF(A)  // [[1, 2], [1, 0]]
+func ColumnSpaceRREF[T int | float64](m Matrix[T], tol ...Tolerance) ([]vectors.Vector[float64], error) {
+	_, pivots, err := RREF(m, tol...)
+	if err != nil {
+		return nil, err
+	}
+	a := gtoFloat64Matrix(m)
+	basis := make([]vectors.Vector[float64], len(pivots))
+	for k, j := range pivots {
+		basis[k] = column(a, j)
+	}
+	return basis, nil
+}
+
+// RowSpaceRREF computes a basis for the row space of a matrix made of the
+// nonzero rows of its reduced row echelon form.
+//
+// Parameters:
+//   - m: Input matrix of type Matrix[T] where T is int or float64, of any shape m×n
+//   - tol: Optional tolerance for the pivots, as for RREF
+//
+// Returns:
+//   - []vectors.Vector[float64]: One vector of length n per pivot, with a 1 in
+//     its pivot column and zeros in the other pivot columns
+//   - error: An error if the matrix is invalid or empty (ErrEmpty)
+func RowSpaceRREF[T int | float64](m Matrix[T], tol ...Tolerance) ([]vectors.Vector[float64], error) {
+	R, pivots, err := RREF(m, tol...)
+	if err != nil {
+		return nil, err
+	}
+	return rowVectors(R, 0, len(pivots)), nil
+}
+
+// LeftNullSpaceRREF computes the textbook basis for the left null space of a
+// matrix, the null space of its transpose, read off the reduced row echelon
+// form of Aᵀ.
+//
+// Parameters:
+//   - m: Input matrix of type Matrix[T] where T is int or float64, of any shape m×n
+//   - tol: Optional tolerance for the pivots, as for RREF
+//
+// Returns:
+//   - []vectors.Vector[float64]: One vector of length m per free column of Aᵀ,
+//     as described for NullSpaceRREF
+//   - error: An error if the matrix is invalid or empty (ErrEmpty)
+func LeftNullSpaceRREF[T int | float64](m Matrix[T], tol ...Tolerance) ([]vectors.Vector[float64], error) {
+	if err := m.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid matrix: %w", err)
+	}
+	return NullSpaceRREF(Transpose(m), tol...)
+}
+
+// subspaceSVD computes the full SVD of m and its numerical rank, returning U,
+// Vᵀ and the rank.
+func subspaceSVD[T int | float64](m Matrix[T], tol []Tolerance) (Matrix[float64], Matrix[float64], int, error) {
+	if err := m.Validate(); err != nil {
+		return nil, nil, 0, fmt.Errorf("invalid matrix: %w", err)
+	}
+	if len(m) == 0 || len(m[0]) == 0 {
+		return nil, nil, 0, ErrEmpty
+	}
+
+	U, S, Vt, err := SVD(m, SVDFull)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	limit := negligible.Threshold(tol, epsTol(max(len(m), len(m[0]))), S[0])
+	return U, Vt, svdRank(S, limit), nil
+}
+
+// specialSolutions returns the null space basis of a reduced row echelon form
+// R with the given pivot columns, one vector per free column.
+func specialSolutions(R Matrix[float64], pivots []int) []vectors.Vector[float64] {
+	cols := len(R[0])
+	isPivot := make([]bool, cols)
+	for _, p := range pivots {
+		isPivot[p] = true
+	}
+
+	basis := make([]vectors.Vector[float64], 0, cols-len(pivots))
+	for f := range cols {
+		if isPivot[f] {
+			continue
+		}
+		x := make(vectors.Vector[float64], cols)
+		x[f] = 1
+		for i, p := range pivots {
+			// Subtract rather than negate, so that a zero entry gives 0, not -0
+			x[p] = 0 - R[i][f]
+		}
+		basis = append(basis, x)
+	}
+	return basis
+}
+
+// rowVectors returns copies of rows lo to hi-1 of m as vectors.
+func rowVectors(m Matrix[float64], lo, hi int) []vectors.Vector[float64] {
+	basis := make([]vectors.Vector[float64], 0, hi-lo)
+	for i := lo; i < hi; i++ {
+		basis = append(basis, vectors.Vector[float64](m[i]).Copy())
+	}
+	return basis
+}
+
+// columnVectors returns columns lo to hi-1 of m as vectors.
+func columnVectors(m Matrix[float64], lo, hi int) []vectors.Vector[float64] {
+	basis := make([]vectors.Vector[float64], 0, hi-lo)
+	for j := lo; j < hi; j++ {
+		basis = append(basis, column(m, j))
+	}
+	return basis
+}
+
+// column returns a copy of column j of m as a vector.
+func column(m Matrix[float64], j int) vectors.Vector[float64] {
+	v := make(vectors.Vector[float64], len(m))
+	for i := range m {
+		v[i] = m[i][j]
+	}
+	return v
+}
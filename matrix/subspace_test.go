@@ -0,0 +1,233 @@
+package matrix
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/rickykimani/linalg/vectors"
+)
+
+func TestSubspaces(t *testing.T) {
+	tests := []struct {
+		name   string
+		matrix Matrix[float64]
+		rank   int
+	}{
+		{name: "Full rank square", matrix: Matrix[float64]{{2, 1}, {1, 3}}, rank: 2},
+		{name: "Rank one", matrix: Matrix[float64]{{1, 2, 3}, {2, 4, 6}}, rank: 1},
+		{name: "Rank deficient 3x4", matrix: Matrix[float64]{{1, 2, 0, 1}, {0, 1, 1, 0}, {1, 3, 1, 1}}, rank: 2},
+		{name: "Tall", matrix: Matrix[float64]{{1, 0}, {0, 1}, {1, 1}}, rank: 2},
+		{name: "Zero matrix", matrix: Matrix[float64]{{0, 0, 0}, {0, 0, 0}}, rank: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rows, cols := tt.matrix.Rows(), tt.matrix.Cols()
+			null, err := NullSpace(tt.matrix)
+			if err != nil {
+				t.Fatalf("NullSpace() error = %v", err)
+			}
+			col, _ := ColumnSpace(tt.matrix)
+			row, _ := RowSpace(tt.matrix)
+			left, _ := LeftNullSpace(tt.matrix)
+
+			// Dimensions follow from the rank-nullity theorem
+			if len(null) != cols-tt.rank || len(row) != tt.rank {
+				t.Errorf("null space and row space have dimensions %d and %d, expected %d and %d",
+					len(null), len(row), cols-tt.rank, tt.rank)
+			}
+			if len(col) != tt.rank || len(left) != rows-tt.rank {
+				t.Errorf("column space and left null space have dimensions %d and %d, expected %d and %d",
+					len(col), len(left), tt.rank, rows-tt.rank)
+			}
+
+			// Row space ⊕ null space = ℝⁿ and column space ⊕ left null space = ℝᵐ,
+			// with orthonormal bases
+			checkOrthonormal(t, append(row, null...))
+			checkOrthonormal(t, append(col, left...))
+
+			// A·x = 0 and yᵀ·A = 0
+			for _, x := range null {
+				ax, _ := MultiplyVector(tt.matrix, x)
+				checkZero(t, "A·x", ax)
+			}
+			for _, y := range left {
+				ya, _ := VectorMultiply(y, tt.matrix)
+				checkZero(t, "yᵀ·A", ya)
+			}
+		})
+	}
+}
+
+func TestSubspaces_Tolerance(t *testing.T) {
+	// Rank two, up to a perturbation of relative size 1e-10
+	m := Matrix[float64]{{1, 0, 1}, {0, 1, 1}, {1, 1, 2 + 1e-10}}
+
+	if null, _ := NullSpace(m); len(null) != 0 {
+		t.Errorf("default tolerance: null space dimension %d, expected 0", len(null))
+	}
+	null, err := NullSpace(m, Tolerance{Rel: 1e-8})
+	if err != nil {
+		t.Fatalf("NullSpace() error = %v", err)
+	}
+	if len(null) != 1 {
+		t.Fatalf("loose tolerance: null space dimension %d, expected 1", len(null))
+	}
+	// The null vector is ±(1, 1, -1)/√3
+	s := 1 / math.Sqrt(3)
+	if math.Abs(math.Abs(null[0][0])-s) > 1e-8 || math.Abs(null[0][0]-null[0][1]) > 1e-8 || math.Abs(null[0][0]+null[0][2]) > 1e-8 {
+		t.Errorf("null vector = %v, expected ±(1, 1, -1)/√3", null[0])
+	}
+}
+
+func TestSubspacesRREF(t *testing.T) {
+	A := Matrix[int]{{1, 2, 0, 1}, {0, 1, 1, 0}, {1, 3, 1, 1}}
+
+	tests := []struct {
+		name     string
+		fn       func(Matrix[int], ...Tolerance) ([]vectors.Vector[float64], error)
+		expected []vectors.Vector[float64]
+	}{
+		{
+			name:     "NullSpaceRREF",
+			fn:       NullSpaceRREF[int],
+			expected: []vectors.Vector[float64]{{2, -1, 1, 0}, {-1, 0, 0, 1}},
+		},
+		{
+			name:     "ColumnSpaceRREF",
+			fn:       ColumnSpaceRREF[int],
+			expected: []vectors.Vector[float64]{{1, 0, 1}, {2, 1, 3}},
+		},
+		{
+			name:     "RowSpaceRREF",
+			fn:       RowSpaceRREF[int],
+			expected: []vectors.Vector[float64]{{1, 0, -2, 1}, {0, 1, 1, 0}},
+		},
+		{
+			name:     "LeftNullSpaceRREF",
+			fn:       LeftNullSpaceRREF[int],
+			expected: []vectors.Vector[float64]{{-1, -1, 1}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			basis, err := tt.fn(A)
+			if err != nil {
+				t.Fatalf("%s() error = %v", tt.name, err)
+			}
+			if len(basis) != len(tt.expected) {
+				t.Fatalf("got %d vectors %v, expected %v", len(basis), basis, tt.expected)
+			}
+			for k := range basis {
+				for i := range basis[k] {
+					if math.Abs(basis[k][i]-tt.expected[k][i]) > 1e-12 {
+						t.Errorf("vector %d = %v, expected %v", k, basis[k], tt.expected[k])
+						break
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestSubspacesRREF_FullRank(t *testing.T) {
+	A := Matrix[int]{{2, 1}, {1, 3}}
+	null, err := NullSpaceRREF(A)
+	if err != nil {
+		t.Fatalf("NullSpaceRREF() error = %v", err)
+	}
+	if null == nil || len(null) != 0 {
+		t.Errorf("NullSpaceRREF() = %v, expected an empty basis", null)
+	}
+	if left, _ := LeftNullSpaceRREF(A); len(left) != 0 {
+		t.Errorf("LeftNullSpaceRREF() = %v, expected an empty basis", left)
+	}
+}
+
+func TestSubspacesRREF_NoNegativeZero(t *testing.T) {
+	null, err := NullSpaceRREF(Matrix[int]{{1, 2, 1}, {2, 4, 0}})
+	if err != nil {
+		t.Fatalf("NullSpaceRREF() error = %v", err)
+	}
+	if len(null) != 1 || !rowsAlmostEqual(null[0], []float64{-2, 1, 0}, 0) {
+		t.Fatalf("NullSpaceRREF() = %v, expected [[-2 1 0]]", null)
+	}
+	if math.Signbit(null[0][2]) {
+		t.Errorf("NullSpaceRREF() = %v, expected +0 rather than -0", null)
+	}
+}
+
+func TestSubspaces_Errors(t *testing.T) {
+	fns := map[string]func(Matrix[float64], ...Tolerance) ([]vectors.Vector[float64], error){
+		"NullSpace":         NullSpace[float64],
+		"ColumnSpace":       ColumnSpace[float64],
+		"RowSpace":          RowSpace[float64],
+		"LeftNullSpace":     LeftNullSpace[float64],
+		"NullSpaceRREF":     NullSpaceRREF[float64],
+		"ColumnSpaceRREF":   ColumnSpaceRREF[float64],
+		"RowSpaceRREF":      RowSpaceRREF[float64],
+		"LeftNullSpaceRREF": LeftNullSpaceRREF[float64],
+	}
+
+	for name, fn := range fns {
+		t.Run(name, func(t *testing.T) {
+			if _, err := fn(Matrix[float64]{}); !errors.Is(err, ErrEmpty) {
+				t.Errorf("expected ErrEmpty, got %v", err)
+			}
+			if _, err := fn(Matrix[float64]{{1, 2}, {3}}); err == nil {
+				t.Error("expected error for improper matrix")
+			}
+		})
+	}
+}
+
+// checkOrthonormal verifies that the vectors have unit length and are
+// mutually orthogonal.
+func checkOrthonormal(t *testing.T, basis []vectors.Vector[float64]) {
+	t.Helper()
+	for i := range basis {
+		for j := i; j < len(basis); j++ {
+			d, err := vectors.Dot(basis[i], basis[j])
+			if err != nil {
+				t.Fatalf("Dot() error = %v", err)
+			}
+			want := 0.0
+			if i == j {
+				want = 1
+			}
+			if math.Abs(d-want) > 1e-12 {
+				t.Errorf("basis[%d]·basis[%d] = %g, expected %g", i, j, d, want)
+			}
+		}
+	}
+}
+
+// checkZero verifies that every element of v is negligible.
+func checkZero(t *testing.T, name string, v vectors.Vector[float64]) {
+	t.Helper()
+	for i, x := range v {
+		if math.Abs(x) > 1e-12 {
+			t.Errorf("%s[%d] = %g, expected 0", name, i, x)
+		}
+	}
+}
+
+func BenchmarkNullSpace(b *testing.B) {
+	sizes := []int{10, 50, 100}
+
+	for _, size := range sizes {
+		b.Run(fmt.Sprintf("%dx%d", size, size), func(b *testing.B) {
+			A := randomFloatMatrix(size, size)
+			for j := range A {
+				A[j][size-1] = A[j][0] + A[j][1] // Rank deficient
+			}
+			b.ResetTimer()
+			for b.Loop() {
+				_, _ = NullSpace(A)
+			}
+		})
+	}
+}
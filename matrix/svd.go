@@ -0,0 +1,490 @@
+package matrix
+
+import (
+	"fmt"
+	"math"
+)
+
+// SVDMode selects the shape of the factors returned by SVD.
+type SVDMode int
+
+const (
+	// SVDThin returns the economy-size decomposition: for an m×n matrix with
+	// k = min(m, n), U is m×k and Vᵀ is k×n.
+	SVDThin SVDMode = iota
+
+	// SVDFull returns the complete decomposition: U is m×m and Vᵀ is n×n.
+	SVDFull
+)
+
+// svdMaxSweeps bounds the number of implicit QR steps per singular value.
+const svdMaxSweeps = 75
+
+// SVD computes the singular value decomposition A = U·Σ·Vᵀ of a rectangular matrix.
+//
+// Parameters:
+//   - m: Input matrix of type Matrix[T] where T is int or float64, of any shape m×n
+//   - mode: SVDThin for the economy-size factors or SVDFull for square U and Vᵀ
+//
+// Returns:
+//   - Matrix[float64]: U, whose columns are the left singular vectors
+//   - []float64: The k = min(m, n) singular values in non-increasing order
+//   - Matrix[float64]: Vᵀ, whose rows are the right singular vectors
+//   - error: Returns error if the matrix is empty or non-rectangular, the mode is
+//     unknown (ErrInvalidArgument), or the iteration fails to converge
+//
+// The matrix is first reduced to upper bidiagonal form with Householder
+// reflections (Golub–Kahan bidiagonalization), after which the bidiagonal is
+// diagonalized with implicitly shifted QR steps (Golub–Reinsch). Both U and V
+// are orthogonal to machine precision and all singular values are non-negative.
+//
+// The singular values directly give the 2-norm (σ₁), the numerical rank (the
+// count of σᵢ above a tolerance) and the condition number (σ₁/σₖ). Use
+// SingularValues when U and Vᵀ are not needed.
+//
+// Time complexity: O(m·n·min(m, n)).
+func SVD[T int | float64](m Matrix[T], mode SVDMode) (Matrix[float64], []float64, Matrix[float64], error) {
+	if err := m.Validate(); err != nil {
+		return nil, nil, nil, err
+	}
+
+	if len(m) == 0 || len(m[0]) == 0 {
+		return nil, nil, nil, ErrEmpty
+	}
+
+	if mode != SVDThin && mode != SVDFull {
+		return nil, nil, nil, fmt.Errorf("unknown SVD mode %d: %w", mode, ErrInvalidArgument)
+	}
+
+	rows, cols := len(m), len(m[0])
+	full := mode == SVDFull
+
+	// The bidiagonalization works on tall matrices; for a wide matrix
+	// decompose Aᵀ = U'·Σ·V'ᵀ, so that A = V'·Σ·U'ᵀ.
+	if rows < cols {
+		u, s, v, err := golubKahanSVD(Transpose(gtoFloat64Matrix(m)), true, true, full)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		if !full {
+			v = firstColumns(v, rows)
+		}
+		return v, s, Transpose(u), nil
+	}
+
+	u, s, v, err := golubKahanSVD(gtoFloat64Matrix(m), true, true, full)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return u, s, Transpose(v), nil
+}
+
+// SingularValues computes the singular values of a rectangular matrix without
+// forming the singular vectors.
+//
+// Parameters:
+//   - m: Input matrix of type Matrix[T] where T is int or float64
+//
+// Returns:
+//   - []float64: The min(m, n) singular values in non-increasing order
+//   - error: Returns error if the matrix is empty or non-rectangular, or the
+//     iteration fails to converge
+//
+// This is the same algorithm as SVD but skips accumulating U and V, which
+// makes it several times faster.
+func SingularValues[T int | float64](m Matrix[T]) ([]float64, error) {
+	if err := m.Validate(); err != nil {
+		return nil, err
+	}
+
+	if len(m) == 0 || len(m[0]) == 0 {
+		return nil, ErrEmpty
+	}
+
+	a := gtoFloat64Matrix(m)
+	if len(a) < len(a[0]) {
+		a = Transpose(a)
+	}
+
+	_, s, _, err := golubKahanSVD(a, false, false, false)
+	return s, err
+}
+
+// firstColumns returns a copy of the first k columns of m.
+func firstColumns(m Matrix[float64], k int) Matrix[float64] {
+	result := make(Matrix[float64], len(m))
+	for i := range m {
+		result[i] = make([]float64, k)
+		copy(result[i], m[i][:k])
+	}
+	return result
+}
+
+// golubKahanSVD computes the SVD of a, which must have at least as many rows
+// as columns and is overwritten. It returns U (m×n, or m×m when fullU is set),
+// the n singular values in non-increasing order and V (n×n). U and V are nil
+// when not requested.
+//
+// The algorithm follows the LINPACK routine DSVDC.
+func golubKahanSVD(a Matrix[float64], wantU, wantV, fullU bool) (Matrix[float64], []float64, Matrix[float64], error) {
+	m, n := len(a), len(a[0])
+	nu := n
+	if fullU {
+		nu = m
+	}
+
+	s := make([]float64, min(m+1, n))
+	e := make([]float64, n)
+	work := make([]float64, m)
+
+	var u, v Matrix[float64]
+	if wantU {
+		u, _ = NewEmptyMatrix(m, nu)
+	}
+	if wantV {
+		v, _ = NewEmptyMatrix(n, n)
+	}
+
+	// Reduce A to bidiagonal form, storing the diagonal elements in s and the
+	// super-diagonal elements in e.
+	nct := min(m-1, n)
+	nrt := max(0, min(n-2, m))
+	for k := range max(nct, nrt) {
+		if k < nct {
+			// Householder reflection for column k; the diagonal goes to s[k]
+			s[k] = 0
+			for i := k; i < m; i++ {
+				s[k] = math.Hypot(s[k], a[i][k])
+			}
+			if s[k] != 0 {
+				if a[k][k] < 0 {
+					s[k] = -s[k]
+				}
+				for i := k; i < m; i++ {
+					a[i][k] /= s[k]
+				}
+				a[k][k] += 1
+			}
+			s[k] = -s[k]
+		}
+
+		for j := k + 1; j < n; j++ {
+			if k < nct && s[k] != 0 {
+				// Apply the column transformation
+				t := 0.0
+				for i := k; i < m; i++ {
+					t += a[i][k] * a[i][j]
+				}
+				t = -t / a[k][k]
+				for i := k; i < m; i++ {
+					a[i][j] += t * a[i][k]
+				}
+			}
+			// Row k is needed for the row transformation below
+			e[j] = a[k][j]
+		}
+
+		if wantU && k < nct {
+			// Keep the reflector for back multiplication
+			for i := k; i < m; i++ {
+				u[i][k] = a[i][k]
+			}
+		}
+
+		if k < nrt {
+			// Householder reflection for row k; the super-diagonal goes to e[k]
+			e[k] = 0
+			for i := k + 1; i < n; i++ {
+				e[k] = math.Hypot(e[k], e[i])
+			}
+			if e[k] != 0 {
+				if e[k+1] < 0 {
+					e[k] = -e[k]
+				}
+				for i := k + 1; i < n; i++ {
+					e[i] /= e[k]
+				}
+				e[k+1] += 1
+			}
+			e[k] = -e[k]
+
+			if k+1 < m && e[k] != 0 {
+				// Apply the row transformation
+				for i := k + 1; i < m; i++ {
+					work[i] = 0
+				}
+				for j := k + 1; j < n; j++ {
+					for i := k + 1; i < m; i++ {
+						work[i] += e[j] * a[i][j]
+					}
+				}
+				for j := k + 1; j < n; j++ {
+					t := -e[j] / e[k+1]
+					for i := k + 1; i < m; i++ {
+						a[i][j] += t * work[i]
+					}
+				}
+			}
+
+			if wantV {
+				// Keep the reflector for back multiplication
+				for i := k + 1; i < n; i++ {
+					v[i][k] = e[i]
+				}
+			}
+		}
+	}
+
+	// Set up the final bidiagonal matrix of order p
+	p := min(n, m+1)
+	if nct < n {
+		s[nct] = a[nct][nct]
+	}
+	if m < p {
+		s[p-1] = 0
+	}
+	if nrt+1 < p {
+		e[nrt] = a[nrt][p-1]
+	}
+	e[p-1] = 0
+
+	// Generate U from the stored reflectors
+	if wantU {
+		for j := nct; j < nu; j++ {
+			for i := range m {
+				u[i][j] = 0
+			}
+			u[j][j] = 1
+		}
+		for k := nct - 1; k >= 0; k-- {
+			if s[k] != 0 {
+				for j := k + 1; j < nu; j++ {
+					t := 0.0
+					for i := k; i < m; i++ {
+						t += u[i][k] * u[i][j]
+					}
+					t = -t / u[k][k]
+					for i := k; i < m; i++ {
+						u[i][j] += t * u[i][k]
+					}
+				}
+				for i := k; i < m; i++ {
+					u[i][k] = -u[i][k]
+				}
+				u[k][k] = 1 + u[k][k]
+				for i := range k {
+					u[i][k] = 0
+				}
+			} else {
+				for i := range m {
+					u[i][k] = 0
+				}
+				u[k][k] = 1
+			}
+		}
+	}
+
+	// Generate V from the stored reflectors
+	if wantV {
+		for k := n - 1; k >= 0; k-- {
+			if k < nrt && e[k] != 0 {
+				for j := k + 1; j < n; j++ {
+					t := 0.0
+					for i := k + 1; i < n; i++ {
+						t += v[i][k] * v[i][j]
+					}
+					t = -t / v[k+1][k]
+					for i := k + 1; i < n; i++ {
+						v[i][j] += t * v[i][k]
+					}
+				}
+			}
+			for i := range n {
+				v[i][k] = 0
+			}
+			v[k][k] = 1
+		}
+	}
+
+	// Main iteration loop for the singular values
+	pp := p - 1
+	iter := 0
+	tiny := math.Pow(2, -966)
+	for p > 0 {
+		if iter > svdMaxSweeps*n {
+			return nil, nil, nil, fmt.Errorf("SVD: %w", ErrNoConvergence)
+		}
+
+		// Classify the trailing part of the bidiagonal:
+		//   kase 1: s[p-1] and e[k-1] are negligible and k < p
+		//   kase 2: s[k] is negligible and k < p
+		//   kase 3: e[k-1] is negligible, k < p, and s[k..p-1] are not (QR step)
+		//   kase 4: e[p-2] is negligible (convergence)
+		var k, kase int
+		for k = p - 2; k >= 0; k-- {
+			if math.Abs(e[k]) <= tiny+machEps*(math.Abs(s[k])+math.Abs(s[k+1])) {
+				e[k] = 0
+				break
+			}
+		}
+
+		if k == p-2 {
+			kase = 4
+		} else {
+			ks := p - 1
+			for ; ks > k; ks-- {
+				t := 0.0
+				if ks != p {
+					t += math.Abs(e[ks])
+				}
+				if ks != k+1 {
+					t += math.Abs(e[ks-1])
+				}
+				if math.Abs(s[ks]) <= tiny+machEps*t {
+					s[ks] = 0
+					break
+				}
+			}
+			switch ks {
+			case k:
+				kase = 3
+			case p - 1:
+				kase = 1
+			default:
+				kase = 2
+				k = ks
+			}
+		}
+		k++
+
+		switch kase {
+		case 1:
+			// Deflate negligible s[p-1]
+			f := e[p-2]
+			e[p-2] = 0
+			for j := p - 2; j >= k; j-- {
+				t := math.Hypot(s[j], f)
+				cs, sn := s[j]/t, f/t
+				s[j] = t
+				if j != k {
+					f = -sn * e[j-1]
+					e[j-1] = cs * e[j-1]
+				}
+				if wantV {
+					rotateColumns(v, j, p-1, cs, sn)
+				}
+			}
+
+		case 2:
+			// Split at negligible s[k-1]
+			f := e[k-1]
+			e[k-1] = 0
+			for j := k; j < p; j++ {
+				t := math.Hypot(s[j], f)
+				cs, sn := s[j]/t, f/t
+				s[j] = t
+				f = -sn * e[j]
+				e[j] = cs * e[j]
+				if wantU {
+					rotateColumns(u, j, k-1, cs, sn)
+				}
+			}
+
+		case 3:
+			// One implicitly shifted QR step, with the shift taken from the
+			// trailing 2×2 block
+			scale := max(math.Abs(s[p-1]), math.Abs(s[p-2]), math.Abs(e[p-2]),
+				math.Abs(s[k]), math.Abs(e[k]))
+			sp := s[p-1] / scale
+			spm1 := s[p-2] / scale
+			epm1 := e[p-2] / scale
+			sk := s[k] / scale
+			ek := e[k] / scale
+			b := ((spm1+sp)*(spm1-sp) + epm1*epm1) / 2
+			c := (sp * epm1) * (sp * epm1)
+			shift := 0.0
+			if b != 0 || c != 0 {
+				shift = math.Sqrt(b*b + c)
+				if b < 0 {
+					shift = -shift
+				}
+				shift = c / (b + shift)
+			}
+			f := (sk+sp)*(sk-sp) + shift
+			g := sk * ek
+
+			// Chase the bulge down the bidiagonal
+			for j := k; j < p-1; j++ {
+				t := math.Hypot(f, g)
+				cs, sn := f/t, g/t
+				if j != k {
+					e[j-1] = t
+				}
+				f = cs*s[j] + sn*e[j]
+				e[j] = cs*e[j] - sn*s[j]
+				g = sn * s[j+1]
+				s[j+1] = cs * s[j+1]
+				if wantV {
+					rotateColumns(v, j, j+1, cs, sn)
+				}
+
+				t = math.Hypot(f, g)
+				cs, sn = f/t, g/t
+				s[j] = t
+				f = cs*e[j] + sn*s[j+1]
+				s[j+1] = -sn*e[j] + cs*s[j+1]
+				g = sn * e[j+1]
+				e[j+1] = cs * e[j+1]
+				if wantU && j < m-1 {
+					rotateColumns(u, j, j+1, cs, sn)
+				}
+			}
+			e[p-2] = f
+			iter++
+
+		case 4:
+			// Make the singular value non-negative
+			if s[k] <= 0 {
+				s[k] = math.Abs(s[k])
+				if wantV {
+					for i := range n {
+						v[i][k] = -v[i][k]
+					}
+				}
+			}
+
+			// Sort it into place
+			for k < pp && s[k] < s[k+1] {
+				s[k], s[k+1] = s[k+1], s[k]
+				if wantV && k < n-1 {
+					swapColumns(v, k, k+1)
+				}
+				if wantU && k < m-1 {
+					swapColumns(u, k, k+1)
+				}
+				k++
+			}
+			iter = 0
+			p--
+		}
+	}
+
+	return u, s[:n], v, nil
+}
+
+// rotateColumns applies a Givens rotation to columns j and k of m:
+// (m_j, m_k) ← (cs·m_j + sn·m_k, -sn·m_j + cs·m_k).
+func rotateColumns(m Matrix[float64], j, k int, cs, sn float64) {
+	for i := range m {
+		t := cs*m[i][j] + sn*m[i][k]
+		m[i][k] = -sn*m[i][j] + cs*m[i][k]
+		m[i][j] = t
+	}
+}
+
+// swapColumns exchanges columns j and k of m.
+func swapColumns(m Matrix[float64], j, k int) {
+	for i := range m {
+		m[i][j], m[i][k] = m[i][k], m[i][j]
+	}
+}
@@ -0,0 +1,174 @@
+package matrix
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestSVD(t *testing.T) {
+	tests := []struct {
+		name  string
+		input Matrix[float64]
+		want  []float64
+	}{
+		{
+			name: "2x2 known values",
+			input: Matrix[float64]{
+				{3, 0},
+				{4, 5},
+			},
+			want: []float64{math.Sqrt(45), math.Sqrt(5)},
+		},
+		{
+			name: "diagonal with unsorted entries",
+			input: Matrix[float64]{
+				{1, 0, 0},
+				{0, -3, 0},
+				{0, 0, 2},
+			},
+			want: []float64{3, 2, 1},
+		},
+		{
+			name: "rank deficient",
+			input: Matrix[float64]{
+				{1, 2, 3},
+				{2, 4, 6},
+				{3, 6, 9},
+			},
+			want: []float64{14, 0, 0},
+		},
+		{
+			name:  "tall",
+			input: randomFloatMatrix(7, 4),
+		},
+		{
+			name:  "wide",
+			input: randomFloatMatrix(3, 6),
+		},
+		{
+			name:  "single row",
+			input: Matrix[float64]{{3, 4}},
+			want:  []float64{5},
+		},
+		{
+			name:  "zero matrix",
+			input: Matrix[float64]{{0, 0}, {0, 0}, {0, 0}},
+			want:  []float64{0, 0},
+		},
+	}
+
+	for _, tt := range tests {
+		for _, mode := range []SVDMode{SVDThin, SVDFull} {
+			name := tt.name + "/thin"
+			if mode == SVDFull {
+				name = tt.name + "/full"
+			}
+			t.Run(name, func(t *testing.T) {
+				U, S, Vt, err := SVD(tt.input, mode)
+				if err != nil {
+					t.Fatalf("SVD() error = %v", err)
+				}
+
+				rows, cols := tt.input.Rows(), tt.input.Cols()
+				k := min(rows, cols)
+				uCols, vRows := k, k
+				if mode == SVDFull {
+					uCols, vRows = rows, cols
+				}
+				if U.Rows() != rows || U.Cols() != uCols {
+					t.Fatalf("U is %d×%d, want %d×%d", U.Rows(), U.Cols(), rows, uCols)
+				}
+				if Vt.Rows() != vRows || Vt.Cols() != cols {
+					t.Fatalf("Vᵀ is %d×%d, want %d×%d", Vt.Rows(), Vt.Cols(), vRows, cols)
+				}
+				if len(S) != k {
+					t.Fatalf("got %d singular values, want %d", len(S), k)
+				}
+
+				// Singular values are non-negative and sorted
+				for i := range S {
+					if S[i] < 0 {
+						t.Errorf("negative singular value σ%d = %g", i, S[i])
+					}
+					if i > 0 && S[i] > S[i-1] {
+						t.Errorf("singular values not sorted: %v", S)
+					}
+				}
+				for i, want := range tt.want {
+					if math.Abs(S[i]-want) > 1e-12 {
+						t.Errorf("σ%d = %v, want %v", i, S[i], want)
+					}
+				}
+
+				// U and V have orthonormal columns
+				UTU, _ := Multiply(Transpose(U), U)
+				if !matricesAlmostEqual(UTU, Identity(uCols), 1e-13) {
+					t.Errorf("UᵀU != I\n%s", matrixToString(UTU))
+				}
+				VtV, _ := Multiply(Vt, Transpose(Vt))
+				if !matricesAlmostEqual(VtV, Identity(vRows), 1e-13) {
+					t.Errorf("VᵀV != I\n%s", matrixToString(VtV))
+				}
+
+				// A = U·Σ·Vᵀ using the leading k columns and rows
+				US := make(Matrix[float64], rows)
+				for i := range rows {
+					US[i] = make([]float64, k)
+					for j := range k {
+						US[i][j] = U[i][j] * S[j]
+					}
+				}
+				A, _ := Multiply(US, Vt[:k])
+				if !matricesAlmostEqual(A, tt.input, 1e-12) {
+					t.Errorf("U·Σ·Vᵀ != A\n%s", matrixToString(A))
+				}
+			})
+		}
+	}
+}
+
+func TestSingularValues(t *testing.T) {
+	for _, input := range []Matrix[float64]{randomFloatMatrix(6, 6), randomFloatMatrix(5, 8), randomFloatMatrix(9, 2)} {
+		_, want, _, err := SVD(input, SVDThin)
+		if err != nil {
+			t.Fatalf("SVD() error = %v", err)
+		}
+		got, err := SingularValues(input)
+		if err != nil {
+			t.Fatalf("SingularValues() error = %v", err)
+		}
+		if !rowsAlmostEqual(got, want, 1e-12) {
+			t.Errorf("SingularValues() = %v, want %v", got, want)
+		}
+	}
+}
+
+func TestSVD_ErrorCases(t *testing.T) {
+	if _, _, _, err := SVD(Matrix[int]{}, SVDThin); err == nil {
+		t.Error("expected error for empty matrix")
+	}
+	if _, _, _, err := SVD(Matrix[int]{{1, 2}, {3}}, SVDThin); err == nil {
+		t.Error("expected error for improper matrix")
+	}
+	if _, _, _, err := SVD(Matrix[int]{{1}}, SVDMode(3)); err == nil {
+		t.Error("expected error for unknown mode")
+	}
+	if _, err := SingularValues(Matrix[int]{}); err == nil {
+		t.Error("expected error for empty matrix")
+	}
+}
+
+func BenchmarkSVD(b *testing.B) {
+	sizes := []int{10, 50, 100}
+
+	for _, size := range sizes {
+		b.Run(fmt.Sprintf("%dx%d", size, size), func(b *testing.B) {
+			A := randomFloatMatrix(size, size)
+			b.ResetTimer()
+			for b.Loop() {
+				_, _, _, _ = SVD(A, SVDThin)
+			}
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package matrix
+
+import "github.com/rickykimani/linalg/vectors"
+
+// Tolerance decides when a computed quantity, such as a pivot or the norm of
+// an orthogonalized column, is small enough to be treated as zero.
+//
+// A quantity is negligible when it does not exceed
+//
+//	max(Abs, Rel·scale)
+//
+// where scale is the magnitude of the input the check applies to, usually a
+// norm of the matrix; each function documents its scale. Relative thresholds
+// make a check independent of units: a matrix with 1e-8-sized elements is
+// singular exactly when the same matrix scaled by 1e8 is.
+//
+// The zero Tolerance selects the default, which for most functions is a
+// relative tolerance of n·ε where n is the largest matrix dimension and
+// ε ≈ 2.2e-16 is the machine epsilon. This is the level of rounding error that
+// backward-stable algorithms such as LU with partial pivoting and Householder
+// QR introduce. Less stable algorithms document a looser default.
+//
+// Functions accept a Tolerance as an optional trailing argument:
+//
+//	r := Rank(A)                           // Default relative tolerance
+//	r = Rank(A, Tolerance{Rel: 1e-8})      // Looser, for noisy data
+//	r = Rank(A, Tolerance{Abs: 1e-12})     // Absolute, ignoring the scale of A
+//
+// Tolerance is the same type as vectors.Tolerance.
+type Tolerance = vectors.Tolerance
+
+// epsTol returns the default relative tolerance n·ε for dimension n.
+func epsTol(n int) float64 {
+	return float64(n) * machEps
+}
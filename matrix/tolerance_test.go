@@ -0,0 +1,158 @@
+package matrix
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/rickykimani/linalg/vectors"
+)
+
+// scaled returns s·m.
+func scaled(m Matrix[float64], s float64) Matrix[float64] {
+	result := cloneMatrix(m)
+	for _, row := range result {
+		for j := range row {
+			row[j] *= s
+		}
+	}
+	return result
+}
+
+func TestTolerance_ScaleInvariance(t *testing.T) {
+	A := Matrix[float64]{{4, 3, 2}, {1, 5, 7}, {2, 8, 3}}
+	singular := Matrix[float64]{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}}
+	b := vectors.Vector[float64]{1, 2, 3}
+
+	for _, s := range []float64{1e-8, 1e-14, 1, 1e10} {
+		As := scaled(A, s)
+
+		if f, _ := NewLU(As); f.IsSingular() {
+			t.Errorf("scale %g: NewLU reports a nonsingular matrix as singular", s)
+		}
+		if _, _, _, err := LUDecompose(As); err != nil {
+			t.Errorf("scale %g: LUDecompose: %v", s, err)
+		}
+		if _, err := Inverse(As); err != nil {
+			t.Errorf("scale %g: Inverse: %v", s, err)
+		}
+		if _, err := Solve(As, b); err != nil {
+			t.Errorf("scale %g: Solve: %v", s, err)
+		}
+		if _, _, err := QRDecompose(As); err != nil {
+			t.Errorf("scale %g: QRDecompose: %v", s, err)
+		}
+		AtA, _ := Multiply(Transpose(As), As)
+		if f, _ := NewLDLT(AtA); f.IsSingular() {
+			t.Errorf("scale %g: NewLDLT reports a nonsingular matrix as singular", s)
+		}
+		if r := Rank(As); r != 3 {
+			t.Errorf("scale %g: Rank = %d, expected 3", s, r)
+		}
+
+		ss := scaled(singular, s)
+		if r := Rank(ss); r != 2 {
+			t.Errorf("scale %g: Rank of singular matrix = %d, expected 2", s, r)
+		}
+		if _, err := Inverse(ss); err == nil {
+			t.Errorf("scale %g: Inverse of singular matrix succeeded", s)
+		}
+		if _, _, err := QRDecompose(ss); err == nil {
+			t.Errorf("scale %g: QRDecompose of singular matrix succeeded", s)
+		}
+	}
+}
+
+func TestTolerance_Custom(t *testing.T) {
+	// Rank 2 up to a perturbation of 1e-9
+	A := Matrix[float64]{{1, 2, 3}, {4, 5, 6}, {7, 8, 9 + 1e-9}}
+
+	if r := Rank(A); r != 3 {
+		t.Errorf("default Rank = %d, expected 3", r)
+	}
+	if r := Rank(A, Tolerance{Rel: 1e-6}); r != 2 {
+		t.Errorf("Rank with relative tolerance 1e-6 = %d, expected 2", r)
+	}
+	if r := Rank(A, Tolerance{Abs: 1e-6}); r != 2 {
+		t.Errorf("Rank with absolute tolerance 1e-6 = %d, expected 2", r)
+	}
+
+	if f, _ := NewLU(A, Tolerance{Rel: 1e-6}); !f.IsSingular() {
+		t.Error("NewLU with relative tolerance 1e-6 should report singular")
+	}
+	if _, _, _, err := LUDecompose(A, Tolerance{Rel: 1e-6}); !errors.Is(err, ErrSingular) {
+		t.Errorf("LUDecompose: expected ErrSingular, got %v", err)
+	}
+	if _, err := Inverse(A, Tolerance{Rel: 1e-6}); err == nil {
+		t.Error("Inverse with relative tolerance 1e-6 should fail")
+	}
+	if _, err := Solve(A, vectors.Vector[float64]{1, 2, 3}, Tolerance{Rel: 1e-6}); err == nil {
+		t.Error("Solve with relative tolerance 1e-6 should fail")
+	}
+	if _, _, err := QRDecompose(A, Tolerance{Rel: 1e-6}); err == nil {
+		t.Error("QRDecompose with relative tolerance 1e-6 should fail")
+	}
+	// Gram-Schmidt defaults to the looser √ε, so it needs a tighter tolerance
+	// to accept the perturbed column
+	if _, _, err := QRDecompose(A); err == nil {
+		t.Error("default QRDecompose should treat the perturbed column as dependent")
+	}
+	if _, _, err := QRDecompose(A, Tolerance{Rel: 1e-12}); err != nil {
+		t.Errorf("QRDecompose with relative tolerance 1e-12: %v", err)
+	}
+
+	// Least squares honors the tolerance for its rank check
+	tall := Matrix[float64]{{1, 1}, {1, 1 + 1e-9}, {1, 1}}
+	if _, err := Solve(tall, vectors.Vector[float64]{1, 2, 3}, Tolerance{Rel: 1e-6}); !errors.Is(err, ErrSingular) {
+		t.Errorf("rectangular Solve: expected ErrSingular, got %v", err)
+	}
+	if _, err := SolveMatrix(tall, Matrix[float64]{{1}, {2}, {3}}, Tolerance{Rel: 1e-6}); !errors.Is(err, ErrSingular) {
+		t.Errorf("rectangular SolveMatrix: expected ErrSingular, got %v", err)
+	}
+
+	// A tolerance can also be looser than the default for LDLT
+	S := Matrix[float64]{{1, 1}, {1, 1 + 1e-9}}
+	if f, _ := NewLDLT(S); f.IsSingular() {
+		t.Error("default NewLDLT should not report singular")
+	}
+	if f, _ := NewLDLT(S, Tolerance{Rel: 1e-6}); !f.IsSingular() {
+		t.Error("NewLDLT with relative tolerance 1e-6 should report singular")
+	}
+}
+
+func TestTolerance_SVDAndSymmetry(t *testing.T) {
+	// A singular value of 1e-9 is kept by default and dropped by a looser
+	// relative tolerance
+	A := Matrix[float64]{{1, 0}, {0, 1e-9}, {0, 0}}
+	b := vectors.Vector[float64]{1, 1, 0}
+	if res, _ := LeastSquares(A, b); res.Rank != 2 {
+		t.Errorf("LeastSquares rank = %d, expected 2", res.Rank)
+	}
+	if res, _ := LeastSquares(A, b, Tolerance{Rel: 1e-6}); res.Rank != 1 || res.X[1] != 0 {
+		t.Errorf("LeastSquares with relative tolerance 1e-6: rank %d, x = %v", res.Rank, res.X)
+	}
+	if P, _ := PseudoInverse(scaled(A, 1e8), Tolerance{Rel: 1e-6}); P[1][1] != 0 {
+		t.Errorf("PseudoInverse with relative tolerance 1e-6 kept σ₂: %v", P)
+	}
+	if c, _ := Cond(A); math.Abs(c-1e9) > 1 {
+		t.Errorf("Cond = %g, expected 1e9", c)
+	}
+	if c, _ := Cond(A, Tolerance{Rel: 1e-6}); !math.IsInf(c, 1) {
+		t.Errorf("Cond with relative tolerance 1e-6 = %g, expected +Inf", c)
+	}
+
+	// A rounded symmetric matrix passes only with a looser symmetry tolerance
+	S := Matrix[float64]{{4, 1 + 1e-8}, {1, 3}}
+	if _, err := NewCholesky(S); !errors.Is(err, ErrNotSymmetric) {
+		t.Errorf("NewCholesky: expected ErrNotSymmetric, got %v", err)
+	}
+	if _, err := NewCholesky(S, Tolerance{Rel: 1e-6}); err != nil {
+		t.Errorf("NewCholesky with relative tolerance 1e-6: %v", err)
+	}
+	if _, _, err := EigenSym(S, Tolerance{Rel: 1e-6}); err != nil {
+		t.Errorf("EigenSym with relative tolerance 1e-6: %v", err)
+	}
+	if _, err := NewLDLT(S, Tolerance{Rel: 1e-6}); err != nil {
+		t.Errorf("NewLDLT with relative tolerance 1e-6: %v", err)
+	}
+}
@@ -1,9 +1,6 @@
 package matrix
 
-import (
-	"errors"
-	"fmt"
-)
+import "fmt"
 
 // Trace calculates the sum of elements on the main diagonal of a square matrix.
 //
@@ -15,7 +12,7 @@ import (
 //
 // Returns:
 //   - T: The trace value, with the same type as the input matrix elements
-//   - error: Returns error if the matrix is empty or non-square
+//   - error: Returns error if the matrix is empty (ErrEmpty) or non-square (ErrNotSquare)
 //
 // The trace is only defined for square matrices. For non-square matrices, this
 // function will return an error.
@@ -28,11 +25,11 @@ func Trace[T int | float64](m Matrix[T]) (T, error) {
 	}
 
 	if len(m) == 0 {
-		return 0, errors.New("cannot find trace of an empty matrix")
+		return 0, fmt.Errorf("cannot find trace: %w", ErrEmpty)
 	}
 
 	if !m.isSquare() {
-		return 0, errors.New("cannot find trace of a non-square matrix")
+		return 0, fmt.Errorf("cannot find trace: %w", ErrNotSquare)
 	}
 
 	var trace T
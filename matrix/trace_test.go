@@ -1,6 +1,7 @@
 package matrix
 
 import (
+	"errors"
 	"fmt"
 	"testing"
 )
@@ -11,6 +12,7 @@ func TestTrace(t *testing.T) {
 		input    any
 		expected any
 		wantErr  bool
+		target   error
 		errorMsg string
 	}{
 		// Integer matrix tests
@@ -100,7 +102,7 @@ func TestTrace(t *testing.T) {
 			},
 			expected: 0,
 			wantErr:  true,
-			errorMsg: "cannot find trace of a non-square matrix",
+			target:   ErrNotSquare,
 		},
 		{
 			name: "non-square integer matrix (3x2)",
@@ -111,7 +113,7 @@ func TestTrace(t *testing.T) {
 			},
 			expected: 0,
 			wantErr:  true,
-			errorMsg: "cannot find trace of a non-square matrix",
+			target:   ErrNotSquare,
 		},
 		{
 			name: "non-square float64 matrix",
@@ -121,21 +123,21 @@ func TestTrace(t *testing.T) {
 			},
 			expected: 0.0,
 			wantErr:  true,
-			errorMsg: "cannot find trace of a non-square matrix",
+			target:   ErrNotSquare,
 		},
 		{
 			name:     "empty integer matrix",
 			input:    Matrix[int]{},
 			expected: 0,
 			wantErr:  true,
-			errorMsg: "cannot find trace of an empty matrix",
+			target:   ErrEmpty,
 		},
 		{
 			name:     "empty float64 matrix",
 			input:    Matrix[float64]{},
 			expected: 0.0,
 			wantErr:  true,
-			errorMsg: "cannot find trace of an empty matrix",
+			target:   ErrEmpty,
 		},
 		{
 			name: "single row matrix (1xN)",
@@ -144,7 +146,7 @@ func TestTrace(t *testing.T) {
 			},
 			expected: 0,
 			wantErr:  true,
-			errorMsg: "cannot find trace of a non-square matrix",
+			target:   ErrNotSquare,
 		},
 		{
 			name: "Inconsistent matrix",
@@ -169,6 +171,9 @@ func TestTrace(t *testing.T) {
 						t.Errorf("Trace() expected error, got nil")
 						return
 					}
+					if tt.target != nil && !errors.Is(err, tt.target) {
+						t.Errorf("Trace() error = %v, expected %v", err, tt.target)
+					}
 					if tt.errorMsg != "" && err.Error() != tt.errorMsg {
 						t.Errorf("Trace() error = %v, expected %v", err.Error(), tt.errorMsg)
 					}
@@ -197,6 +202,9 @@ func TestTrace(t *testing.T) {
 						t.Errorf("Trace() expected error, got nil")
 						return
 					}
+					if tt.target != nil && !errors.Is(err, tt.target) {
+						t.Errorf("Trace() error = %v, expected %v", err, tt.target)
+					}
 					if tt.errorMsg != "" && err.Error() != tt.errorMsg {
 						t.Errorf("Trace() error = %v, expected %v", err.Error(), tt.errorMsg)
 					}
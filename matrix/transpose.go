@@ -1,5 +1,7 @@
 package matrix
 
+import "fmt"
+
 // Transpose returns the transpose of a matrix.
 //
 // The transpose of a matrix is formed by flipping the matrix over its main diagonal,
@@ -39,3 +41,38 @@ func Transpose[T int | float64](m Matrix[T]) Matrix[T] {
 
 	return result
 }
+
+// TransposeTo stores the transpose of m in dst.
+//
+// Parameters:
+//   - dst: Destination matrix with dimensions n×m for an m×n input, typically a view
+//   - m: Input matrix of type Matrix[T] where T is int or float64
+//
+// Returns:
+//   - error: An error if either matrix is invalid or empty, or the dimensions do not match
+//
+// No memory is allocated. dst must not share storage with m, since it is
+// overwritten while m is still being read.
+func TransposeTo[T int | float64](dst, m Matrix[T]) error {
+	if err := m.Validate(); err != nil {
+		return err
+	}
+	if err := dst.Validate(); err != nil {
+		return fmt.Errorf("destination: %w", err)
+	}
+
+	if len(m) == 0 || len(dst) == 0 {
+		return ErrEmpty
+	}
+
+	if dst.Rows() != m.Cols() || dst.Cols() != m.Rows() {
+		return dimensionError("TransposeTo", dst.shape(), m.shape())
+	}
+
+	for i, row := range m {
+		for j, v := range row {
+			dst[j][i] = v
+		}
+	}
+	return nil
+}
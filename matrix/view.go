@@ -0,0 +1,96 @@
+package matrix
+
+import "fmt"
+
+// Slice returns a view of the submatrix with rows r0 to r1-1 and columns c0
+// to c1-1.
+//
+// Parameters:
+//   - r0, r1: The half-open row range [r0, r1)
+//   - c0, c1: The half-open column range [c0, c1)
+//
+// Returns:
+//   - Matrix[T]: An (r1-r0)×(c1-c0) matrix sharing storage with m
+//   - error: An error if the matrix is invalid or a range is out of bounds
+//     (ErrInvalidArgument)
+//
+// No elements are copied: the rows of the view are reslices of the rows of
+// m, so writes through the view change m and vice versa. Each row of the view
+// is capped at its own length, so appending to it never overwrites the
+// neighbouring elements of m. A view can be passed to any function in this
+// package, including as the destination of AddTo, MultiplyTo and TransposeTo,
+// which is what blocked algorithms need.
+//
+// An empty row range yields an empty matrix, and an empty column range yields
+// r1-r0 empty rows, matching the shape of NewEmptyMatrix(r1-r0, 0).
+//
+// Example:
+//
+//	A := Matrix[int]{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}}
+//	B, _ := A.Slice(1, 3, 1, 3)  // [[5, 6], [8, 9]]
+//	B[0][0] = 50                 // A[1][1] is now 50
+//
+// Time complexity: O(r1-r0), independent of the number of columns.
+func (m *Matrix[T]) Slice(r0, r1, c0, c1 int) (Matrix[T], error) {
+	if err := m.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := checkSliceBounds(r0, r1, c0, c1, m.Rows(), m.Cols()); err != nil {
+		return nil, err
+	}
+
+	if r0 == r1 {
+		return Matrix[T]{}, nil
+	}
+
+	view := make(Matrix[T], r1-r0)
+	for i := range view {
+		view[i] = (*m)[r0+i][c0:c1:c1]
+	}
+	return view, nil
+}
+
+// Row returns a 1×n view of row i that shares storage with m.
+//
+// Parameters:
+//   - i: The row index (0-based)
+//
+// Returns:
+//   - Matrix[T]: A single-row matrix sharing storage with m
+//   - error: An error if the matrix is invalid or the index is out of bounds
+//     (ErrInvalidArgument)
+func (m *Matrix[T]) Row(i int) (Matrix[T], error) {
+	if i < 0 || i >= len(*m) {
+		return nil, fmt.Errorf("row index %d out of bounds for matrix with %d rows: %w", i, len(*m), ErrInvalidArgument)
+	}
+	return m.Slice(i, i+1, 0, m.Cols())
+}
+
+// Col returns an m×1 view of column j that shares storage with m.
+//
+// Parameters:
+//   - j: The column index (0-based)
+//
+// Returns:
+//   - Matrix[T]: A single-column matrix sharing storage with m
+//   - error: An error if the matrix is invalid or the index is out of bounds
+//     (ErrInvalidArgument)
+func (m *Matrix[T]) Col(j int) (Matrix[T], error) {
+	if j < 0 || j >= m.Cols() {
+		return nil, fmt.Errorf("column index %d out of bounds for matrix with %d columns: %w", j, m.Cols(), ErrInvalidArgument)
+	}
+	return m.Slice(0, len(*m), j, j+1)
+}
+
+// checkSliceBounds returns an error unless 0 ≤ r0 ≤ r1 ≤ rows and
+// 0 ≤ c0 ≤ c1 ≤ cols.
+func checkSliceBounds(r0, r1, c0, c1, rows, cols int) error {
+	if r0 < 0 || r1 < r0 || r1 > rows {
+		return fmt.Errorf("row range [%d, %d) out of bounds for matrix with %d rows: %w", r0, r1, rows, ErrInvalidArgument)
+	}
+	if c0 < 0 || c1 < c0 || c1 > cols {
+		return fmt.Errorf("column range [%d, %d) out of bounds for matrix with %d columns: %w", c0, c1, cols, ErrInvalidArgument)
+	}
+	return nil
+}
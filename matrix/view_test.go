@@ -0,0 +1,234 @@
+package matrix
+
+import (
+	"testing"
+)
+
+func TestMatrix_Slice(t *testing.T) {
+	A := Matrix[int]{
+		{1, 2, 3, 4},
+		{5, 6, 7, 8},
+		{9, 10, 11, 12},
+	}
+
+	tests := []struct {
+		name           string
+		r0, r1, c0, c1 int
+		expected       Matrix[int]
+		wantError      bool
+	}{
+		{name: "Interior block", r0: 1, r1: 3, c0: 1, c1: 3, expected: Matrix[int]{{6, 7}, {10, 11}}},
+		{name: "Whole matrix", r0: 0, r1: 3, c0: 0, c1: 4, expected: A},
+		{name: "Single element", r0: 2, r1: 3, c0: 3, c1: 4, expected: Matrix[int]{{12}}},
+		{name: "Empty range", r0: 1, r1: 1, c0: 0, c1: 4, expected: Matrix[int]{}},
+		{name: "Empty column range", r0: 0, r1: 2, c0: 2, c1: 2, expected: Matrix[int]{{}, {}}},
+		{name: "Negative start", r0: -1, r1: 2, c0: 0, c1: 2, wantError: true},
+		{name: "Reversed columns", r0: 0, r1: 2, c0: 3, c1: 1, wantError: true},
+		{name: "Rows past the end", r0: 0, r1: 4, c0: 0, c1: 2, wantError: true},
+		{name: "Columns past the end", r0: 0, r1: 2, c0: 0, c1: 5, wantError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			view, err := A.Slice(tt.r0, tt.r1, tt.c0, tt.c1)
+			if tt.wantError {
+				if err == nil {
+					t.Error("expected error but got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Slice() error = %v", err)
+			}
+			if len(view) != len(tt.expected) {
+				t.Fatalf("Slice() = %v, expected %v", view, tt.expected)
+			}
+			if view.Cols() != tt.expected.Cols() {
+				t.Fatalf("Slice() has %d columns, expected %d", view.Cols(), tt.expected.Cols())
+			}
+			for i := range view {
+				if !rowsAlmostEqual(toFloat64Matrix(view)[i], toFloat64Matrix(tt.expected)[i], 0) {
+					t.Fatalf("Slice() = %v, expected %v", view, tt.expected)
+				}
+			}
+		})
+	}
+
+	if _, err := (&Matrix[int]{{1, 2}, {3}}).Slice(0, 1, 0, 1); err == nil {
+		t.Error("expected error for improper matrix")
+	}
+}
+
+func TestMatrix_SliceSharesStorage(t *testing.T) {
+	A := Matrix[float64]{
+		{1, 2, 3},
+		{4, 5, 6},
+		{7, 8, 9},
+	}
+
+	block, _ := A.Slice(1, 3, 1, 3)
+	block[0][0] = 50
+	if A[1][1] != 50 {
+		t.Errorf("write through view not visible in parent: A[1][1] = %v", A[1][1])
+	}
+	A[2][2] = 90
+	if block[1][1] != 90 {
+		t.Errorf("write to parent not visible in view: %v", block[1][1])
+	}
+
+	// Appending to a view row must not clobber the parent
+	left, _ := A.Slice(0, 1, 0, 1)
+	_ = append(left[0], -1)
+	if A[0][1] != 2 {
+		t.Errorf("append to view overwrote A[0][1] = %v", A[0][1])
+	}
+
+	row, err := A.Row(2)
+	if err != nil || len(row) != 1 || len(row[0]) != 3 || row[0][0] != 7 {
+		t.Fatalf("Row(2) = %v, %v", row, err)
+	}
+	col, err := A.Col(0)
+	if err != nil || len(col) != 3 || len(col[0]) != 1 || col[2][0] != 7 {
+		t.Fatalf("Col(0) = %v, %v", col, err)
+	}
+	col[1][0] = 40
+	if A[1][0] != 40 {
+		t.Errorf("write through column view not visible: A[1][0] = %v", A[1][0])
+	}
+
+	if _, err := A.Row(3); err == nil {
+		t.Error("Row(3): expected error")
+	}
+	if _, err := A.Col(-1); err == nil {
+		t.Error("Col(-1): expected error")
+	}
+}
+
+// TestBlockedMultiply forms C = A·B block by block through views, the access
+// pattern of blocked algorithms.
+func TestBlockedMultiply(t *testing.T) {
+	const n, bs = 6, 3
+	A := randomFloatMatrix(n, n)
+	B := randomFloatMatrix(n, n)
+	C, _ := NewEmptyMatrix(n, n)
+	tmp, _ := NewEmptyMatrix(bs, bs)
+
+	for i := 0; i < n; i += bs {
+		for j := 0; j < n; j += bs {
+			Cij, _ := C.Slice(i, i+bs, j, j+bs)
+			for k := 0; k < n; k += bs {
+				Aik, _ := A.Slice(i, i+bs, k, k+bs)
+				Bkj, _ := B.Slice(k, k+bs, j, j+bs)
+				if err := MultiplyTo(tmp, Aik, Bkj); err != nil {
+					t.Fatalf("MultiplyTo() error = %v", err)
+				}
+				if err := AddTo(Cij, Cij, tmp); err != nil {
+					t.Fatalf("AddTo() error = %v", err)
+				}
+			}
+		}
+	}
+
+	expected, _ := Multiply(A, B)
+	if !matricesAlmostEqual(C, expected, 1e-10) {
+		t.Errorf("blocked product differs from Multiply\n%s", matrixToString(C))
+	}
+
+	// Transpose the top-right block into the bottom-left block of a copy
+	D := cloneMatrix(C)
+	src, _ := C.Slice(0, bs, bs, n)
+	dst, _ := D.Slice(bs, n, 0, bs)
+	if err := TransposeTo(dst, src); err != nil {
+		t.Fatalf("TransposeTo() error = %v", err)
+	}
+	for i := range bs {
+		for j := range bs {
+			if D[bs+j][i] != C[i][bs+j] {
+				t.Fatalf("TransposeTo() wrong at block [%d][%d]", j, i)
+			}
+		}
+	}
+}
+
+func TestDense_Slice(t *testing.T) {
+	d, _ := NewDense(3, 4, []float64{
+		1, 2, 3, 4,
+		5, 6, 7, 8,
+		9, 10, 11, 12,
+	})
+
+	view, err := d.Slice(1, 3, 1, 3)
+	if err != nil {
+		t.Fatalf("Slice() error = %v", err)
+	}
+	if r, c := view.Dims(); r != 2 || c != 2 || view.Stride() != 4 {
+		t.Fatalf("view is %d×%d with stride %d, expected 2×2 with stride 4", r, c, view.Stride())
+	}
+	if !matricesAlmostEqual(view.Matrix(), Matrix[float64]{{6, 7}, {10, 11}}, 0) {
+		t.Errorf("view.Matrix() = %v", view.Matrix())
+	}
+
+	_ = view.Set(1, 1, 110)
+	if v, _ := d.Get(2, 2); v != 110 {
+		t.Errorf("write through view not visible in parent: %v", v)
+	}
+	if _, err := view.Get(0, 2); err == nil {
+		t.Error("Get outside the view: expected error")
+	}
+
+	clone := view.Clone()
+	if clone.Stride() != 2 || !matricesAlmostEqual(clone.Matrix(), view.Matrix(), 0) {
+		t.Errorf("Clone() = %v with stride %d", clone.Matrix(), clone.Stride())
+	}
+
+	col, _ := d.Col(3)
+	if !matricesAlmostEqual(col.Matrix(), Matrix[float64]{{4}, {8}, {12}}, 0) {
+		t.Errorf("Col(3) = %v", col.Matrix())
+	}
+	row, _ := d.Row(0)
+	if !matricesAlmostEqual(row.Matrix(), Matrix[float64]{{1, 2, 3, 4}}, 0) {
+		t.Errorf("Row(0) = %v", row.Matrix())
+	}
+
+	if _, err := d.Slice(0, 4, 0, 1); err == nil {
+		t.Error("Slice past the end: expected error")
+	}
+	if _, err := d.Row(-1); err == nil {
+		t.Error("Row(-1): expected error")
+	}
+	if _, err := d.Col(4); err == nil {
+		t.Error("Col(4): expected error")
+	}
+}
+
+func TestOutputVariants_Errors(t *testing.T) {
+	a := Matrix[int]{{1, 2}, {3, 4}}
+	small, _ := NewEmptyMatrix(1, 2)
+	square, _ := NewEmptyMatrix(2, 2)
+
+	if err := AddTo(small, a, a); err == nil {
+		t.Error("AddTo with wrong destination size: expected error")
+	}
+	if err := SubtractTo(square, a, Matrix[int]{{1, 2}}); err == nil {
+		t.Error("SubtractTo with mismatched operands: expected error")
+	}
+	if err := MultiplyTo(small, a, a); err == nil {
+		t.Error("MultiplyTo with wrong destination size: expected error")
+	}
+	if err := MultiplyTo(square, a, Matrix[int]{{1, 2}}); err == nil {
+		t.Error("MultiplyTo with incompatible operands: expected error")
+	}
+	if err := TransposeTo(Matrix[int]{{0, 0}}, a); err == nil {
+		t.Error("TransposeTo with wrong destination size: expected error")
+	}
+	if err := AddTo(Matrix[float64]{}, a, a); err == nil {
+		t.Error("AddTo with empty destination: expected error")
+	}
+
+	if err := SubtractTo(square, a, Matrix[float64]{{1, 1}, {1, 1}}); err != nil {
+		t.Fatalf("SubtractTo() error = %v", err)
+	}
+	if !matricesAlmostEqual(square, Matrix[float64]{{0, 1}, {2, 3}}, 0) {
+		t.Errorf("SubtractTo() = %v", square)
+	}
+}
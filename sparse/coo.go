@@ -0,0 +1,140 @@
+package sparse
+
+import (
+	"github.com/rickykimani/linalg/matrix"
+	"github.com/rickykimani/linalg/vectors"
+)
+
+// COO is a sparse matrix in coordinate format: an unordered list of
+// (row, column, value) triplets.
+//
+// COO is the format for assembly. Entries can be added in any order and the
+// same position may be added more than once, in which case the values are
+// summed, matching the way finite-element stiffness matrices are built from
+// element contributions. Convert to CSR or CSC for computation.
+type COO struct {
+	rows int
+	cols int
+	row  []int
+	col  []int
+	val  []float64
+}
+
+// NewCOO creates an empty rows×cols matrix in coordinate format.
+//
+// Parameters:
+//   - rows: The number of rows
+//   - cols: The number of columns
+//
+// Returns:
+//   - *COO: A matrix with no stored entries
+//   - error: An error if a dimension is negative (matrix.ErrInvalidArgument)
+//
+// Example:
+//
+//	a, _ := NewCOO(2, 2)
+//	a.Add(0, 0, 1)
+//	a.Add(0, 0, 2)  // Summed with the previous entry
+//	a.Add(1, 0, 4)
+//	csr := a.ToCSR()  // [[3, 0], [4, 0]]
+func NewCOO(rows, cols int) (*COO, error) {
+	if err := checkDims(rows, cols); err != nil {
+		return nil, err
+	}
+	return &COO{rows: rows, cols: cols}, nil
+}
+
+// Add appends the entry v at (row, col). Entries at the same position are summed.
+//
+// Returns an error wrapping matrix.ErrInvalidArgument if either index is out of
+// bounds.
+func (c *COO) Add(row, col int, v float64) error {
+	if err := checkIndex(row, col, c.rows, c.cols); err != nil {
+		return err
+	}
+	c.row = append(c.row, row)
+	c.col = append(c.col, col)
+	c.val = append(c.val, v)
+	return nil
+}
+
+// Dims returns the number of rows and columns of the matrix.
+func (c *COO) Dims() (rows, cols int) {
+	return c.rows, c.cols
+}
+
+// NNZ returns the number of stored triplets, counting duplicates separately.
+func (c *COO) NNZ() int {
+	return len(c.val)
+}
+
+// Get returns the element at (row, col), the sum of all triplets stored there.
+//
+// This scans every triplet; convert to CSR or CSC for repeated access.
+func (c *COO) Get(row, col int) (float64, error) {
+	if err := checkIndex(row, col, c.rows, c.cols); err != nil {
+		return 0, err
+	}
+	sum := 0.0
+	for k := range c.val {
+		if c.row[k] == row && c.col[k] == col {
+			sum += c.val[k]
+		}
+	}
+	return sum, nil
+}
+
+// Do calls fn for every stored triplet in insertion order. Duplicate
+// positions are visited once per triplet.
+func (c *COO) Do(fn func(row, col int, v float64)) {
+	for k := range c.val {
+		fn(c.row[k], c.col[k], c.val[k])
+	}
+}
+
+// MulVec returns the matrix-vector product A·x.
+//
+// Returns an error if the length of x does not match the number of columns.
+func (c *COO) MulVec(x vectors.Vector[float64]) (vectors.Vector[float64], error) {
+	if len(x) != c.cols {
+		return nil, &matrix.DimensionError{Op: "COO.MulVec", Shapes: [][2]int{{c.rows, c.cols}, {len(x), 1}}}
+	}
+	y := make(vectors.Vector[float64], c.rows)
+	for k := range c.val {
+		y[c.row[k]] += c.val[k] * x[c.col[k]]
+	}
+	return y, nil
+}
+
+// MulMatrix returns the product A·B with a dense matrix B.
+//
+// Returns an error if B is invalid or empty, or its row count does not match
+// the number of columns.
+func (c *COO) MulMatrix(b matrix.Matrix[float64]) (matrix.Matrix[float64], error) {
+	return c.ToCSR().MulMatrix(b)
+}
+
+// T returns the transpose as a new COO matrix.
+func (c *COO) T() *COO {
+	t := &COO{rows: c.cols, cols: c.rows}
+	t.row = append([]int(nil), c.col...)
+	t.col = append([]int(nil), c.row...)
+	t.val = append([]float64(nil), c.val...)
+	return t
+}
+
+// ToCSR converts the matrix to compressed sparse row format, summing
+// duplicate entries. Entries that sum to zero remain stored.
+//
+// Time complexity: O(nnz·log(nnz/rows)) for sorting within rows.
+func (c *COO) ToCSR() *CSR {
+	indptr, indices, data := compress(c.rows, c.row, c.col, c.val)
+	return &CSR{rows: c.rows, cols: c.cols, indptr: indptr, indices: indices, data: data}
+}
+
+// ToCSC converts the matrix to compressed sparse column format, summing
+// duplicate entries. Entries that sum to zero remain stored.
+func (c *COO) ToCSC() *CSC {
+	indptr, indices, data := compress(c.cols, c.col, c.row, c.val)
+	return &CSC{rows: c.rows, cols: c.cols, indptr: indptr, indices: indices, data: data}
+}
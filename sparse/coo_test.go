@@ -0,0 +1,91 @@
+package sparse
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/rickykimani/linalg/matrix"
+)
+
+func TestCOO(t *testing.T) {
+	a, err := NewCOO(3, 3)
+	if err != nil {
+		t.Fatalf("NewCOO() error = %v", err)
+	}
+
+	// Entries in arbitrary order with duplicates
+	entries := []struct {
+		i, j int
+		v    float64
+	}{
+		{2, 2, 1}, {0, 1, 2}, {2, 0, 3}, {0, 1, 5}, {1, 1, 4}, {2, 2, -1}, {0, 0, 6},
+	}
+	for _, e := range entries {
+		if err := a.Add(e.i, e.j, e.v); err != nil {
+			t.Fatalf("Add(%d, %d) error = %v", e.i, e.j, err)
+		}
+	}
+
+	if a.NNZ() != len(entries) {
+		t.Errorf("NNZ() = %d, expected %d triplets", a.NNZ(), len(entries))
+	}
+	if v, _ := a.Get(0, 1); v != 7 {
+		t.Errorf("Get(0, 1) = %v, expected the duplicates summed to 7", v)
+	}
+
+	expected := matrix.Matrix[float64]{
+		{6, 7, 0},
+		{0, 4, 0},
+		{3, 0, 0},
+	}
+
+	csr := a.ToCSR()
+	if !denseEqual(csr.ToMatrix(), expected, 0) {
+		t.Errorf("ToCSR() = %v, expected %v", csr.ToMatrix(), expected)
+	}
+	// The (2, 2) entries cancel but stay in the structure
+	if csr.NNZ() != 5 {
+		t.Errorf("CSR NNZ() = %d, expected 5", csr.NNZ())
+	}
+	indptr, indices, _ := csr.RawData()
+	if _, err := NewCSR(3, 3, indptr, indices, make([]float64, len(indices))); err != nil {
+		t.Errorf("ToCSR() produced invalid storage: %v", err)
+	}
+
+	csc := a.ToCSC()
+	if !denseEqual(csc.ToMatrix(), expected, 0) {
+		t.Errorf("ToCSC() = %v, expected %v", csc.ToMatrix(), expected)
+	}
+
+	at := a.T()
+	if r, c := at.Dims(); r != 3 || c != 3 {
+		t.Errorf("T().Dims() = %d×%d", r, c)
+	}
+	if v, _ := at.Get(1, 0); v != 7 {
+		t.Errorf("T().Get(1, 0) = %v, expected 7", v)
+	}
+}
+
+func TestCOO_Errors(t *testing.T) {
+	if _, err := NewCOO(-1, 2); !errors.Is(err, matrix.ErrInvalidArgument) {
+		t.Errorf("expected ErrInvalidArgument for negative dimension, got %v", err)
+	}
+
+	a, _ := NewCOO(2, 3)
+	for _, idx := range [][2]int{{-1, 0}, {2, 0}, {0, -1}, {0, 3}} {
+		if err := a.Add(idx[0], idx[1], 1); !errors.Is(err, matrix.ErrInvalidArgument) {
+			t.Errorf("Add(%d, %d): expected ErrInvalidArgument, got %v", idx[0], idx[1], err)
+		}
+	}
+	if a.NNZ() != 0 {
+		t.Errorf("failed Add calls stored entries: NNZ() = %d", a.NNZ())
+	}
+}
+
+func BenchmarkCOOToCSR(b *testing.B) {
+	a := laplacian1D(100_000)
+	b.ResetTimer()
+	for b.Loop() {
+		_ = a.ToCSR()
+	}
+}
@@ -0,0 +1,147 @@
+package sparse
+
+import (
+	"github.com/rickykimani/linalg/matrix"
+	"github.com/rickykimani/linalg/vectors"
+)
+
+// CSC is a sparse matrix in compressed sparse column format.
+//
+// The row indices and values of column j are stored in
+// indices[indptr[j]:indptr[j+1]] and data[indptr[j]:indptr[j+1]], with the
+// row indices strictly increasing. CSC gives fast column access, which
+// direct factorizations and products with the transpose rely on.
+type CSC struct {
+	rows    int
+	cols    int
+	indptr  []int
+	indices []int
+	data    []float64
+}
+
+// NewCSC creates a CSC matrix from its raw arrays.
+//
+// Parameters:
+//   - rows, cols: The dimensions of the matrix
+//   - indptr: Column pointers of length cols+1, starting at 0 and non-decreasing
+//   - indices: Row index of every stored element, strictly increasing within each column
+//   - data: Value of every stored element
+//
+// Returns:
+//   - *CSC: The matrix, which uses the given slices without copying
+//   - error: An error if the dimensions are negative or the arrays are inconsistent
+//     (matrix.ErrInvalidArgument)
+func NewCSC(rows, cols int, indptr, indices []int, data []float64) (*CSC, error) {
+	if err := checkDims(rows, cols); err != nil {
+		return nil, err
+	}
+	if err := validateCompressed(cols, rows, indptr, indices, data); err != nil {
+		return nil, err
+	}
+	return &CSC{rows: rows, cols: cols, indptr: indptr, indices: indices, data: data}, nil
+}
+
+// Dims returns the number of rows and columns of the matrix.
+func (c *CSC) Dims() (rows, cols int) {
+	return c.rows, c.cols
+}
+
+// NNZ returns the number of stored elements.
+func (c *CSC) NNZ() int {
+	return len(c.data)
+}
+
+// RawData returns the column pointers, row indices and values without copying.
+//
+// The slices must not be modified in a way that breaks the CSC invariants.
+func (c *CSC) RawData() (indptr, indices []int, data []float64) {
+	return c.indptr, c.indices, c.data
+}
+
+// Get returns the element at (row, col), found by binary search within the column.
+//
+// Returns an error wrapping matrix.ErrInvalidArgument if either index is out of
+// bounds.
+func (c *CSC) Get(row, col int) (float64, error) {
+	if err := checkIndex(row, col, c.rows, c.cols); err != nil {
+		return 0, err
+	}
+	if k := search(c.indices, c.indptr[col], c.indptr[col+1], row); k >= 0 {
+		return c.data[k], nil
+	}
+	return 0, nil
+}
+
+// Do calls fn for every stored element in column-major order.
+func (c *CSC) Do(fn func(row, col int, v float64)) {
+	for j := range c.cols {
+		for k := c.indptr[j]; k < c.indptr[j+1]; k++ {
+			fn(c.indices[k], j, c.data[k])
+		}
+	}
+}
+
+// MulVec returns the matrix-vector product A·x.
+//
+// Returns an error if the length of x does not match the number of columns.
+//
+// Time complexity: O(nnz).
+func (c *CSC) MulVec(x vectors.Vector[float64]) (vectors.Vector[float64], error) {
+	if len(x) != c.cols {
+		return nil, &matrix.DimensionError{Op: "CSC.MulVec", Shapes: [][2]int{{c.rows, c.cols}, {len(x), 1}}}
+	}
+
+	y := make(vectors.Vector[float64], c.rows)
+	for j := range c.cols {
+		xj := x[j]
+		for k := c.indptr[j]; k < c.indptr[j+1]; k++ {
+			y[c.indices[k]] += c.data[k] * xj
+		}
+	}
+	return y, nil
+}
+
+// MulMatrix returns the product A·B with a dense matrix B.
+//
+// Returns an error if B is invalid or empty, or its row count does not match
+// the number of columns.
+//
+// Time complexity: O(nnz × columns of B).
+func (c *CSC) MulMatrix(b matrix.Matrix[float64]) (matrix.Matrix[float64], error) {
+	if err := checkMulMatrix(c.cols, b); err != nil {
+		return nil, err
+	}
+
+	result, _ := matrix.NewEmptyMatrix(c.rows, b.Cols())
+	for j := range c.cols {
+		bj := b[j]
+		for k := c.indptr[j]; k < c.indptr[j+1]; k++ {
+			v := c.data[k]
+			ri := result[c.indices[k]]
+			for l, bjl := range bj {
+				ri[l] += v * bjl
+			}
+		}
+	}
+	return result, nil
+}
+
+// T returns the transpose without copying.
+//
+// The column storage of A is exactly the row storage of Aᵀ, so the result is
+// a CSR matrix sharing the arrays of c.
+func (c *CSC) T() *CSR {
+	return &CSR{rows: c.cols, cols: c.rows, indptr: c.indptr, indices: c.indices, data: c.data}
+}
+
+// ToCSR converts the matrix to compressed sparse row format.
+//
+// Time complexity: O(nnz·log(nnz/rows)).
+func (c *CSC) ToCSR() *CSR {
+	return c.T().ToCSC().T()
+}
+
+// ToMatrix converts the matrix to a dense matrix.Matrix[float64].
+func (c *CSC) ToMatrix() matrix.Matrix[float64] {
+	return ToMatrix(c)
+}
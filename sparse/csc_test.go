@@ -0,0 +1,47 @@
+package sparse
+
+import (
+	"testing"
+
+	"github.com/rickykimani/linalg/matrix"
+)
+
+func TestNewCSC(t *testing.T) {
+	// [[1, 0], [2, 3], [0, 4]] stored by columns
+	a, err := NewCSC(3, 2, []int{0, 2, 4}, []int{0, 1, 1, 2}, []float64{1, 2, 3, 4})
+	if err != nil {
+		t.Fatalf("NewCSC() error = %v", err)
+	}
+	expected := matrix.Matrix[float64]{{1, 0}, {2, 3}, {0, 4}}
+	if !denseEqual(a.ToMatrix(), expected, 0) {
+		t.Errorf("ToMatrix() = %v, expected %v", a.ToMatrix(), expected)
+	}
+
+	if _, err := NewCSC(3, 2, []int{0, 2, 4}, []int{0, 3, 1, 2}, []float64{1, 2, 3, 4}); err == nil {
+		t.Error("expected error for row index out of range")
+	}
+	if _, err := NewCSC(3, 2, []int{0, 2, 4, 4}, []int{0, 1, 1, 2}, []float64{1, 2, 3, 4}); err == nil {
+		t.Error("expected error for wrong pointer length")
+	}
+}
+
+func TestCSC_Conversions(t *testing.T) {
+	a, _ := FromMatrix(testDense)
+	csc := a.ToCSC()
+
+	var visited [][2]int
+	csc.Do(func(i, j int, _ float64) { visited = append(visited, [2]int{i, j}) })
+	expected := [][2]int{{0, 0}, {2, 0}, {1, 2}, {2, 2}, {0, 3}}
+	for k := range expected {
+		if k >= len(visited) || visited[k] != expected[k] {
+			t.Fatalf("Do visited %v, expected column-major order %v", visited, expected)
+		}
+	}
+
+	if !denseEqual(csc.T().ToMatrix(), matrix.Transpose(testDense), 0) {
+		t.Errorf("T() = %v", csc.T().ToMatrix())
+	}
+	if !denseEqual(csc.ToCSR().ToMatrix(), testDense, 0) {
+		t.Errorf("ToCSR() = %v", csc.ToCSR().ToMatrix())
+	}
+}
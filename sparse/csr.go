@@ -0,0 +1,166 @@
+package sparse
+
+import (
+	"github.com/rickykimani/linalg/matrix"
+	"github.com/rickykimani/linalg/vectors"
+)
+
+// CSR is a sparse matrix in compressed sparse row format.
+//
+// The column indices and values of row i are stored in
+// indices[indptr[i]:indptr[i+1]] and data[indptr[i]:indptr[i+1]], with the
+// column indices strictly increasing. Each row can be traversed in order and
+// a matrix-vector product touches every stored element exactly once, which
+// makes CSR the format of choice for iterative solvers.
+type CSR struct {
+	rows    int
+	cols    int
+	indptr  []int
+	indices []int
+	data    []float64
+}
+
+// NewCSR creates a CSR matrix from its raw arrays.
+//
+// Parameters:
+//   - rows, cols: The dimensions of the matrix
+//   - indptr: Row pointers of length rows+1, starting at 0 and non-decreasing
+//   - indices: Column index of every stored element, strictly increasing within each row
+//   - data: Value of every stored element
+//
+// Returns:
+//   - *CSR: The matrix, which uses the given slices without copying
+//   - error: An error if the dimensions are negative or the arrays are inconsistent
+//     (matrix.ErrInvalidArgument)
+func NewCSR(rows, cols int, indptr, indices []int, data []float64) (*CSR, error) {
+	if err := checkDims(rows, cols); err != nil {
+		return nil, err
+	}
+	if err := validateCompressed(rows, cols, indptr, indices, data); err != nil {
+		return nil, err
+	}
+	return &CSR{rows: rows, cols: cols, indptr: indptr, indices: indices, data: data}, nil
+}
+
+// Dims returns the number of rows and columns of the matrix.
+func (c *CSR) Dims() (rows, cols int) {
+	return c.rows, c.cols
+}
+
+// NNZ returns the number of stored elements.
+func (c *CSR) NNZ() int {
+	return len(c.data)
+}
+
+// RawData returns the row pointers, column indices and values without copying.
+//
+// The slices must not be modified in a way that breaks the CSR invariants.
+func (c *CSR) RawData() (indptr, indices []int, data []float64) {
+	return c.indptr, c.indices, c.data
+}
+
+// Get returns the element at (row, col), found by binary search within the row.
+//
+// Returns an error wrapping matrix.ErrInvalidArgument if either index is out of
+// bounds.
+func (c *CSR) Get(row, col int) (float64, error) {
+	if err := checkIndex(row, col, c.rows, c.cols); err != nil {
+		return 0, err
+	}
+	if k := search(c.indices, c.indptr[row], c.indptr[row+1], col); k >= 0 {
+		return c.data[k], nil
+	}
+	return 0, nil
+}
+
+// Do calls fn for every stored element in row-major order.
+func (c *CSR) Do(fn func(row, col int, v float64)) {
+	for i := range c.rows {
+		for k := c.indptr[i]; k < c.indptr[i+1]; k++ {
+			fn(i, c.indices[k], c.data[k])
+		}
+	}
+}
+
+// MulVec returns the matrix-vector product A·x.
+//
+// Returns an error if the length of x does not match the number of columns.
+//
+// Time complexity: O(nnz).
+func (c *CSR) MulVec(x vectors.Vector[float64]) (vectors.Vector[float64], error) {
+	y := make(vectors.Vector[float64], c.rows)
+	if err := c.MulVecTo(y, x); err != nil {
+		return nil, err
+	}
+	return y, nil
+}
+
+// MulVecTo stores the matrix-vector product A·x in dst without allocating.
+//
+// Returns an error if the length of x does not match the number of columns or
+// the length of dst does not match the number of rows. dst must not share
+// storage with x.
+func (c *CSR) MulVecTo(dst, x vectors.Vector[float64]) error {
+	if len(x) != c.cols || len(dst) != c.rows {
+		return &matrix.DimensionError{Op: "CSR.MulVecTo", Shapes: [][2]int{{len(dst), 1}, {c.rows, c.cols}, {len(x), 1}}}
+	}
+
+	for i := range c.rows {
+		s := 0.0
+		for k := c.indptr[i]; k < c.indptr[i+1]; k++ {
+			s += c.data[k] * x[c.indices[k]]
+		}
+		dst[i] = s
+	}
+	return nil
+}
+
+// MulMatrix returns the product A·B with a dense matrix B.
+//
+// Returns an error if B is invalid or empty, or its row count does not match
+// the number of columns.
+//
+// Time complexity: O(nnz × columns of B).
+func (c *CSR) MulMatrix(b matrix.Matrix[float64]) (matrix.Matrix[float64], error) {
+	if err := checkMulMatrix(c.cols, b); err != nil {
+		return nil, err
+	}
+
+	result, _ := matrix.NewEmptyMatrix(c.rows, b.Cols())
+	for i, ri := range result {
+		for k := c.indptr[i]; k < c.indptr[i+1]; k++ {
+			v := c.data[k]
+			for j, bkj := range b[c.indices[k]] {
+				ri[j] += v * bkj
+			}
+		}
+	}
+	return result, nil
+}
+
+// T returns the transpose without copying.
+//
+// The row storage of A is exactly the column storage of Aᵀ, so the result is
+// a CSC matrix sharing the arrays of c.
+func (c *CSR) T() *CSC {
+	return &CSC{rows: c.cols, cols: c.rows, indptr: c.indptr, indices: c.indices, data: c.data}
+}
+
+// ToCSC converts the matrix to compressed sparse column format.
+//
+// Time complexity: O(nnz·log(nnz/cols)).
+func (c *CSR) ToCSC() *CSC {
+	rowIdx := make([]int, len(c.data))
+	for i := range c.rows {
+		for k := c.indptr[i]; k < c.indptr[i+1]; k++ {
+			rowIdx[k] = i
+		}
+	}
+	indptr, indices, data := compress(c.cols, c.indices, rowIdx, c.data)
+	return &CSC{rows: c.rows, cols: c.cols, indptr: indptr, indices: indices, data: data}
+}
+
+// ToMatrix converts the matrix to a dense matrix.Matrix[float64].
+func (c *CSR) ToMatrix() matrix.Matrix[float64] {
+	return ToMatrix(c)
+}
@@ -0,0 +1,131 @@
+package sparse
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/rickykimani/linalg/matrix"
+)
+
+func TestNewCSR(t *testing.T) {
+	tests := []struct {
+		name      string
+		rows      int
+		cols      int
+		indptr    []int
+		indices   []int
+		data      []float64
+		wantError bool
+	}{
+		{
+			name: "Valid", rows: 2, cols: 3,
+			indptr: []int{0, 2, 3}, indices: []int{0, 2, 1}, data: []float64{1, 2, 3},
+		},
+		{
+			name: "Empty rows", rows: 3, cols: 2,
+			indptr: []int{0, 0, 0, 0}, indices: []int{}, data: []float64{},
+		},
+		{
+			name: "Negative dimension", rows: -1, cols: 2,
+			indptr: []int{0}, wantError: true,
+		},
+		{
+			name: "Wrong pointer length", rows: 2, cols: 2,
+			indptr: []int{0, 1}, indices: []int{0}, data: []float64{1}, wantError: true,
+		},
+		{
+			name: "Pointer does not start at zero", rows: 1, cols: 2,
+			indptr: []int{1, 1}, indices: []int{0}, data: []float64{1}, wantError: true,
+		},
+		{
+			name: "Mismatched data length", rows: 1, cols: 2,
+			indptr: []int{0, 1}, indices: []int{0}, data: []float64{1, 2}, wantError: true,
+		},
+		{
+			name: "Column out of range", rows: 1, cols: 2,
+			indptr: []int{0, 1}, indices: []int{2}, data: []float64{1}, wantError: true,
+		},
+		{
+			name: "Unsorted columns", rows: 1, cols: 3,
+			indptr: []int{0, 2}, indices: []int{2, 0}, data: []float64{1, 2}, wantError: true,
+		},
+		{
+			name: "Duplicate column", rows: 1, cols: 3,
+			indptr: []int{0, 2}, indices: []int{1, 1}, data: []float64{1, 2}, wantError: true,
+		},
+		{
+			name: "Pointer past the end", rows: 2, cols: 2,
+			indptr: []int{0, 5, 2}, indices: []int{0, 1}, data: []float64{1, 2}, wantError: true,
+		},
+		{
+			name: "Decreasing pointer", rows: 2, cols: 2,
+			indptr: []int{0, 2, 1}, indices: []int{0, 1}, data: []float64{1, 2}, wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, err := NewCSR(tt.rows, tt.cols, tt.indptr, tt.indices, tt.data)
+			if tt.wantError {
+				if !errors.Is(err, matrix.ErrInvalidArgument) {
+					t.Errorf("expected ErrInvalidArgument, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("NewCSR() error = %v", err)
+			}
+			if a.NNZ() != len(tt.data) {
+				t.Errorf("NNZ() = %d, expected %d", a.NNZ(), len(tt.data))
+			}
+		})
+	}
+}
+
+func TestCSR_Transpose(t *testing.T) {
+	a, _ := FromMatrix(testDense)
+	at := a.T()
+
+	if r, c := at.Dims(); r != 4 || c != 3 {
+		t.Fatalf("T().Dims() = %d×%d, expected 4×3", r, c)
+	}
+	if !denseEqual(at.ToMatrix(), matrix.Transpose(testDense), 0) {
+		t.Errorf("T() = %v", at.ToMatrix())
+	}
+
+	// The transpose shares storage with the original
+	_, _, data := a.RawData()
+	_, _, tdata := at.RawData()
+	if &data[0] != &tdata[0] {
+		t.Error("T() copied the data")
+	}
+}
+
+func TestCSR_Do(t *testing.T) {
+	a, _ := FromMatrix(testDense)
+
+	var visited [][2]int
+	a.Do(func(i, j int, v float64) {
+		if testDense[i][j] != v {
+			t.Errorf("Do visited (%d, %d) = %v, expected %v", i, j, v, testDense[i][j])
+		}
+		visited = append(visited, [2]int{i, j})
+	})
+
+	expected := [][2]int{{0, 0}, {0, 3}, {1, 2}, {2, 0}, {2, 2}}
+	if len(visited) != len(expected) {
+		t.Fatalf("Do visited %v, expected %v", visited, expected)
+	}
+	for k := range expected {
+		if visited[k] != expected[k] {
+			t.Fatalf("Do visited %v, expected row-major order %v", visited, expected)
+		}
+	}
+}
+
+func TestCSR_MulVecTo(t *testing.T) {
+	a, _ := FromMatrix(testDense)
+	if err := a.MulVecTo(make([]float64, 2), []float64{1, 1, 1, 1}); err == nil {
+		t.Error("MulVecTo with wrong destination length: expected error")
+	}
+}
@@ -0,0 +1,250 @@
+// Package sparse provides sparse matrices that store only their nonzero
+// elements.
+//
+// Three storage formats are available. COO (coordinate) is a list of
+// (row, column, value) triplets and is the format for assembling a matrix
+// entry by entry, for example from finite-element contributions; duplicate
+// entries are summed. CSR (compressed sparse row) and CSC (compressed sparse
+// column) are compact, immutable formats with fast matrix-vector products and
+// row or column access, and are what computations should use once assembly
+// is complete.
+//
+// All formats hold float64 values and implement the Matrix interface.
+// Conversions to and from the dense matrix.Matrix type are provided for
+// interoperability with the rest of the library.
+package sparse
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+
+	"github.com/rickykimani/linalg/matrix"
+	"github.com/rickykimani/linalg/vectors"
+)
+
+// Matrix is the interface implemented by all sparse matrix formats.
+type Matrix interface {
+	// Dims returns the number of rows and columns.
+	Dims() (rows, cols int)
+
+	// Get returns the element at (row, col), which is zero if it is not stored.
+	// It returns an error if either index is out of bounds.
+	Get(row, col int) (float64, error)
+
+	// NNZ returns the number of stored elements.
+	NNZ() int
+
+	// Do calls fn for every stored element. The order depends on the format.
+	Do(fn func(row, col int, v float64))
+
+	// MulVec returns the matrix-vector product A·x.
+	MulVec(x vectors.Vector[float64]) (vectors.Vector[float64], error)
+
+	// MulMatrix returns the product A·B with a dense matrix B.
+	MulMatrix(b matrix.Matrix[float64]) (matrix.Matrix[float64], error)
+}
+
+var (
+	_ Matrix = (*COO)(nil)
+	_ Matrix = (*CSR)(nil)
+	_ Matrix = (*CSC)(nil)
+)
+
+// FromMatrix creates a CSR matrix holding the nonzero elements of a dense matrix.
+//
+// Parameters:
+//   - m: Input matrix of type matrix.Matrix[T] where T is int or float64
+//
+// Returns:
+//   - *CSR: The sparse matrix; zero elements are not stored
+//   - error: An error if the matrix rows have inconsistent lengths
+func FromMatrix[T int | float64](m matrix.Matrix[T]) (*CSR, error) {
+	if err := m.Validate(); err != nil {
+		return nil, err
+	}
+
+	rows, cols := m.Rows(), m.Cols()
+	c := &CSR{rows: rows, cols: cols, indptr: make([]int, rows+1)}
+	for i, row := range m {
+		for j, v := range row {
+			if v != 0 {
+				c.indices = append(c.indices, j)
+				c.data = append(c.data, float64(v))
+			}
+		}
+		c.indptr[i+1] = len(c.indices)
+	}
+	return c, nil
+}
+
+// ToMatrix converts any sparse matrix to a dense matrix.Matrix[float64].
+//
+// A matrix with no rows yields an empty matrix, and one with no columns yields
+// one empty row per row.
+func ToMatrix(a Matrix) matrix.Matrix[float64] {
+	rows, cols := a.Dims()
+	m, _ := matrix.NewEmptyMatrix(rows, cols)
+	if len(m) == 0 {
+		return m
+	}
+	a.Do(func(i, j int, v float64) {
+		m[i][j] += v
+	})
+	return m
+}
+
+// MultiplyVector computes the product A·v of a sparse matrix with a vector of
+// any element type.
+//
+// Parameters:
+//   - a: The sparse matrix
+//   - v: Input vector of type Vector[E] where E is int or float64
+//
+// Returns:
+//   - vectors.Vector[float64]: The product, with one element per row of a
+//   - error: An error if the vector length does not match the number of columns
+func MultiplyVector[E int | float64](a Matrix, v vectors.Vector[E]) (vectors.Vector[float64], error) {
+	return a.MulVec(v.Copy())
+}
+
+// MultiplyMatrix computes the product A·B of a sparse matrix with a dense
+// matrix of any element type.
+//
+// Parameters:
+//   - a: The sparse matrix
+//   - b: Dense matrix of type matrix.Matrix[E] where E is int or float64
+//
+// Returns:
+//   - matrix.Matrix[float64]: The dense product
+//   - error: An error if b is invalid or its row count does not match the
+//     number of columns of a
+func MultiplyMatrix[E int | float64](a Matrix, b matrix.Matrix[E]) (matrix.Matrix[float64], error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+	bf, _ := matrix.NewMatrix(b)
+	return a.MulMatrix(bf)
+}
+
+// checkIndex returns an error wrapping matrix.ErrInvalidArgument if (row, col)
+// lies outside a rows×cols matrix.
+func checkIndex(row, col, rows, cols int) error {
+	if row < 0 || row >= rows {
+		return fmt.Errorf("row index %d out of bounds for matrix with %d rows: %w", row, rows, matrix.ErrInvalidArgument)
+	}
+	if col < 0 || col >= cols {
+		return fmt.Errorf("column index %d out of bounds for matrix with %d columns: %w", col, cols, matrix.ErrInvalidArgument)
+	}
+	return nil
+}
+
+// checkDims returns an error wrapping matrix.ErrInvalidArgument if a dimension
+// is negative.
+func checkDims(rows, cols int) error {
+	if rows < 0 || cols < 0 {
+		return fmt.Errorf("matrix dimensions cannot be negative: got %d×%d: %w", rows, cols, matrix.ErrInvalidArgument)
+	}
+	return nil
+}
+
+// checkMulMatrix verifies that the dense matrix b can multiply a matrix with
+// the given number of columns from the right.
+func checkMulMatrix(cols int, b matrix.Matrix[float64]) error {
+	if err := b.Validate(); err != nil {
+		return err
+	}
+	if len(b) == 0 {
+		return matrix.ErrEmpty
+	}
+	if len(b) != cols {
+		return fmt.Errorf("sparse matrix has %d columns, dense matrix has %d rows: %w", cols, len(b), matrix.ErrDimensionMismatch)
+	}
+	return nil
+}
+
+// compress builds compressed storage from triplets, grouping by the major
+// index (rows for CSR, columns for CSC) and sorting by the minor index within
+// each group. Duplicate entries are summed.
+func compress(nmajor int, major, minor []int, val []float64) (indptr, indices []int, data []float64) {
+	type entry struct {
+		minor int
+		val   float64
+	}
+
+	// Counting sort by the major index
+	indptr = make([]int, nmajor+1)
+	for _, m := range major {
+		indptr[m+1]++
+	}
+	for i := range nmajor {
+		indptr[i+1] += indptr[i]
+	}
+	next := slices.Clone(indptr[:nmajor])
+	entries := make([]entry, len(major))
+	for k, m := range major {
+		entries[next[m]] = entry{minor[k], val[k]}
+		next[m]++
+	}
+
+	// Sort each group and sum duplicates while compacting
+	indices = make([]int, 0, len(entries))
+	data = make([]float64, 0, len(entries))
+	start := 0
+	for i := range nmajor {
+		group := entries[start:indptr[i+1]]
+		start = indptr[i+1]
+		slices.SortStableFunc(group, func(a, b entry) int { return cmp.Compare(a.minor, b.minor) })
+		for k, e := range group {
+			if k > 0 && e.minor == group[k-1].minor {
+				data[len(data)-1] += e.val
+				continue
+			}
+			indices = append(indices, e.minor)
+			data = append(data, e.val)
+		}
+		indptr[i+1] = len(indices)
+	}
+	return indptr, indices, data
+}
+
+// validateCompressed checks the structure of compressed storage with nmajor
+// groups and minor indices in [0, nminor).
+func validateCompressed(nmajor, nminor int, indptr, indices []int, data []float64) error {
+	if len(indptr) != nmajor+1 {
+		return fmt.Errorf("index pointer has length %d, expected %d: %w", len(indptr), nmajor+1, matrix.ErrInvalidArgument)
+	}
+	if indptr[0] != 0 {
+		return fmt.Errorf("index pointer must start at 0: %w", matrix.ErrInvalidArgument)
+	}
+	if len(indices) != len(data) {
+		return fmt.Errorf("indices and data have different lengths: %d and %d: %w", len(indices), len(data), matrix.ErrInvalidArgument)
+	}
+	if indptr[nmajor] != len(indices) {
+		return fmt.Errorf("index pointer ends at %d, expected %d: %w", indptr[nmajor], len(indices), matrix.ErrInvalidArgument)
+	}
+
+	for i := range nmajor {
+		if indptr[i+1] < indptr[i] || indptr[i+1] > len(indices) {
+			return fmt.Errorf("index pointer out of order at position %d: %w", i+1, matrix.ErrInvalidArgument)
+		}
+		for k := indptr[i]; k < indptr[i+1]; k++ {
+			if indices[k] < 0 || indices[k] >= nminor {
+				return fmt.Errorf("index %d out of bounds at position %d: %w", indices[k], k, matrix.ErrInvalidArgument)
+			}
+			if k > indptr[i] && indices[k] <= indices[k-1] {
+				return fmt.Errorf("indices not strictly increasing at position %d: %w", k, matrix.ErrInvalidArgument)
+			}
+		}
+	}
+	return nil
+}
+
+// search returns the position of target in the sorted slice indices[lo:hi],
+// or -1 if it is not present.
+func search(indices []int, lo, hi, target int) int {
+	if k, ok := slices.BinarySearch(indices[lo:hi], target); ok {
+		return lo + k
+	}
+	return -1
+}
@@ -0,0 +1,213 @@
+package sparse
+
+import (
+	"math"
+	"testing"
+
+	"github.com/rickykimani/linalg/matrix"
+	"github.com/rickykimani/linalg/vectors"
+)
+
+// denseEqual reports whether two dense matrices have the same shape and
+// elements within tol.
+func denseEqual(a, b matrix.Matrix[float64], tol float64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if len(a[i]) != len(b[i]) {
+			return false
+		}
+		for j := range a[i] {
+			if math.Abs(a[i][j]-b[i][j]) > tol {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// vectorsEqual reports whether two vectors have the same length and elements within tol.
+func vectorsEqual(a, b vectors.Vector[float64], tol float64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if math.Abs(a[i]-b[i]) > tol {
+			return false
+		}
+	}
+	return true
+}
+
+// laplacian1D assembles the n×n tridiagonal matrix tridiag(-1, 2, -1) the way
+// a finite-element code would, from overlapping 2×2 element matrices.
+func laplacian1D(n int) *COO {
+	a, _ := NewCOO(n, n)
+	for e := range n - 1 {
+		a.Add(e, e, 1)
+		a.Add(e, e+1, -1)
+		a.Add(e+1, e, -1)
+		a.Add(e+1, e+1, 1)
+	}
+	a.Add(0, 0, 1)
+	a.Add(n-1, n-1, 1)
+	return a
+}
+
+var testDense = matrix.Matrix[float64]{
+	{4, 0, 0, 1},
+	{0, 0, 2, 0},
+	{3, 0, 5, 0},
+}
+
+func TestFromMatrix(t *testing.T) {
+	a, err := FromMatrix(testDense)
+	if err != nil {
+		t.Fatalf("FromMatrix() error = %v", err)
+	}
+	if a.NNZ() != 5 {
+		t.Errorf("NNZ() = %d, expected 5", a.NNZ())
+	}
+	if r, c := a.Dims(); r != 3 || c != 4 {
+		t.Errorf("Dims() = %d×%d, expected 3×4", r, c)
+	}
+	if !denseEqual(ToMatrix(a), testDense, 0) {
+		t.Errorf("ToMatrix(FromMatrix(m)) = %v", ToMatrix(a))
+	}
+
+	ints, _ := FromMatrix(matrix.Matrix[int]{{0, 7}, {0, 0}})
+	if v, _ := ints.Get(0, 1); v != 7 || ints.NNZ() != 1 {
+		t.Errorf("FromMatrix of an int matrix: Get(0, 1) = %v, NNZ = %d", v, ints.NNZ())
+	}
+
+	if _, err := FromMatrix(matrix.Matrix[int]{{1, 2}, {3}}); err == nil {
+		t.Error("expected error for improper matrix")
+	}
+}
+
+func TestFormats_Agree(t *testing.T) {
+	csr, _ := FromMatrix(testDense)
+	formats := map[string]Matrix{
+		"CSR":            csr,
+		"CSC":            csr.ToCSC(),
+		"CSC round trip": csr.ToCSC().ToCSR(),
+	}
+	coo, _ := NewCOO(3, 4)
+	csr.Do(func(i, j int, v float64) { coo.Add(i, j, v) })
+	formats["COO"] = coo
+
+	x := vectors.Vector[float64]{1, 2, 3, 4}
+	expectedY, _ := matrix.MultiplyVector(testDense, x)
+	B := matrix.Matrix[float64]{{1, 0}, {0, 1}, {2, 2}, {-1, 3}}
+	expectedAB, _ := matrix.Multiply(testDense, B)
+
+	for name, a := range formats {
+		t.Run(name, func(t *testing.T) {
+			if !denseEqual(ToMatrix(a), testDense, 0) {
+				t.Errorf("ToMatrix() = %v", ToMatrix(a))
+			}
+			for i := range testDense {
+				for j := range testDense[i] {
+					if v, err := a.Get(i, j); err != nil || v != testDense[i][j] {
+						t.Fatalf("Get(%d, %d) = %v, %v; expected %v", i, j, v, err, testDense[i][j])
+					}
+				}
+			}
+
+			y, err := a.MulVec(x)
+			if err != nil {
+				t.Fatalf("MulVec() error = %v", err)
+			}
+			if !vectorsEqual(y, expectedY, 0) {
+				t.Errorf("MulVec() = %v, expected %v", y, expectedY)
+			}
+
+			AB, err := a.MulMatrix(B)
+			if err != nil {
+				t.Fatalf("MulMatrix() error = %v", err)
+			}
+			if !denseEqual(AB, expectedAB, 1e-12) {
+				t.Errorf("MulMatrix() = %v, expected %v", AB, expectedAB)
+			}
+
+			// A product with no columns keeps one empty row per row of A
+			empty := matrix.Matrix[float64]{{}, {}, {}, {}}
+			if A0, err := a.MulMatrix(empty); err != nil || len(A0) != len(testDense) || A0.Cols() != 0 {
+				t.Errorf("MulMatrix() with zero columns = %v, %v; expected %d×0", A0, err, len(testDense))
+			}
+
+			if _, err := a.Get(3, 0); err == nil {
+				t.Error("Get out of bounds: expected error")
+			}
+			if _, err := a.MulVec(vectors.Vector[float64]{1, 2}); err == nil {
+				t.Error("MulVec with wrong length: expected error")
+			}
+			if _, err := a.MulMatrix(matrix.Matrix[float64]{{1}, {2}}); err == nil {
+				t.Error("MulMatrix with wrong shape: expected error")
+			}
+		})
+	}
+}
+
+func TestMultiplyGeneric(t *testing.T) {
+	a, _ := FromMatrix(testDense)
+
+	y, err := MultiplyVector(a, vectors.Vector[int]{1, 1, 1, 1})
+	if err != nil {
+		t.Fatalf("MultiplyVector() error = %v", err)
+	}
+	if !vectorsEqual(y, vectors.Vector[float64]{5, 2, 8}, 0) {
+		t.Errorf("MultiplyVector() = %v", y)
+	}
+
+	AB, err := MultiplyMatrix(a, matrix.Matrix[int]{{1}, {1}, {1}, {1}})
+	if err != nil {
+		t.Fatalf("MultiplyMatrix() error = %v", err)
+	}
+	if !denseEqual(AB, matrix.Matrix[float64]{{5}, {2}, {8}}, 0) {
+		t.Errorf("MultiplyMatrix() = %v", AB)
+	}
+
+	if _, err := MultiplyMatrix(a, matrix.Matrix[int]{{1}, {1, 2}}); err == nil {
+		t.Error("expected error for improper matrix")
+	}
+}
+
+func TestLargeAssembly(t *testing.T) {
+	const n = 100_000
+	a := laplacian1D(n).ToCSR()
+	if a.NNZ() != 3*n-2 {
+		t.Fatalf("NNZ() = %d, expected %d", a.NNZ(), 3*n-2)
+	}
+
+	// The rows of tridiag(-1, 2, -1) sum to zero except the first and last
+	ones := make(vectors.Vector[float64], n)
+	for i := range ones {
+		ones[i] = 1
+	}
+	y, _ := a.MulVec(ones)
+	for i, v := range y {
+		expected := 0.0
+		if i == 0 || i == n-1 {
+			expected = 1
+		}
+		if v != expected {
+			t.Fatalf("(A·1)[%d] = %v, expected %v", i, v, expected)
+		}
+	}
+}
+
+func BenchmarkCSRMulVec(b *testing.B) {
+	const n = 100_000
+	a := laplacian1D(n).ToCSR()
+	x := make(vectors.Vector[float64], n)
+	y := make(vectors.Vector[float64], n)
+	for i := range x {
+		x[i] = float64(i)
+	}
+	b.ResetTimer()
+	for b.Loop() {
+		_ = a.MulVecTo(y, x)
+	}
+}
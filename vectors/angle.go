@@ -1,7 +1,7 @@
 package vectors
 
 import (
-	"errors"
+	"fmt"
 	"math"
 )
 
@@ -21,15 +21,15 @@ import (
 //     either vector is a zero vector (which has no defined direction)
 func angleCosine[T, E int | float64](a Vector[T], b Vector[E]) (float64, error) {
 	if len(a) != len(b) {
-		return 0, errors.New("vectors must have same dimension")
+		return 0, mismatch("Angle", len(a), len(b))
 	}
 	if len(a) == 0 {
-		return 0, errors.New("vectors cannot be empty")
+		return 0, ErrEmpty
 	}
 
 	// Consider using the IsZero function instead of manual checks
 	if IsZero(a) || IsZero(b) {
-		return 0, errors.New("cannot compute angle with zero vector")
+		return 0, fmt.Errorf("cannot compute angle: %w", ErrZeroVector)
 	}
 
 	// Could also use Dot and Magnitude functions to avoid duplicating logic:
@@ -44,11 +44,6 @@ func angleCosine[T, E int | float64](a Vector[T], b Vector[E]) (float64, error)
 	cos := dot / (aMag * bMag)
 
 	// Clamp to [-1, 1] to handle floating point errors
-	const epsilon = 1e-10
-	if math.Abs(cos) > 1.0 && math.Abs(cos-1.0) < epsilon {
-		return math.Copysign(1.0, cos), nil
-	}
-
 	return math.Max(-1.0, math.Min(1.0, cos)), nil
 }
 
@@ -1,7 +1,5 @@
 package vectors
 
-import "errors"
-
 // Add combines two vectors by element-wise addition.
 //
 // Parameters:
@@ -16,7 +14,7 @@ import "errors"
 // The result is always a float64 vector to accommodate mixed-type operations.
 func Add[T, E int | float64](a Vector[T], b Vector[E]) (Vector[float64], error) {
 	if len(a) != len(b) {
-		return nil, errors.New("vectors must have the same dimension")
+		return nil, mismatch("Add", len(a), len(b))
 	}
 	result := make(Vector[float64], len(a))
 	for i := range a {
@@ -39,7 +37,7 @@ func Add[T, E int | float64](a Vector[T], b Vector[E]) (Vector[float64], error)
 // The result is always a float64 vector to accommodate mixed-type operations.
 func Subtract[T, E int | float64](a Vector[T], b Vector[E]) (Vector[float64], error) {
 	if len(a) != len(b) {
-		return nil, errors.New("vectors must have the same dimension")
+		return nil, mismatch("Subtract", len(a), len(b))
 	}
 	result := make(Vector[float64], len(a))
 	for i := range a {
@@ -45,3 +45,11 @@ func TestCartesianToSpherical(t *testing.T) {
 		t.Errorf("Unexpected spherical coordinates: rho=%v, theta=%v, phi=%v", rho, theta, phi)
 	}
 }
+
+func TestCartesianToSpherical_Tiny(t *testing.T) {
+	// A tiny vector still has well-defined angles
+	rho, theta, phi, err := CartesianToSpherical(Vector[float64]{0, 1e-200, 0})
+	if err != nil || rho != 1e-200 || !almostEqual(theta, math.Pi/2, 1e-12) || !almostEqual(phi, math.Pi/2, 1e-12) {
+		t.Errorf("Unexpected spherical coordinates: rho=%v, theta=%v, phi=%v", rho, theta, phi)
+	}
+}
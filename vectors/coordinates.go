@@ -1,7 +1,7 @@
 package vectors
 
 import (
-	"errors"
+	"fmt"
 	"math"
 )
 
@@ -21,8 +21,8 @@ import (
 // The angle θ is normalized to the range [-π, π] by the Atan2 function.
 // For the zero vector (0,0), the angle is technically undefined but returns 0.
 func CartesianToPolar[T int | float64](v Vector[T]) (r, theta float64, err error) {
-	if len(v) != 2 {
-		return 0, 0, errors.New("vector must be 2D")
+	if err := requireDim("CartesianToPolar", 2, len(v)); err != nil {
+		return 0, 0, err
 	}
 
 	x, y := float64(v[0]), float64(v[1])
@@ -43,7 +43,7 @@ func CartesianToPolar[T int | float64](v Vector[T]) (r, theta float64, err error
 //
 // Returns:
 //   - Vector[float64]: 2D vector in Cartesian coordinates (x,y)
-//   - error: Error if radius is negative
+//   - error: Error if radius is negative (ErrInvalidArgument)
 //
 // conversion formulas:
 //
@@ -52,7 +52,7 @@ func CartesianToPolar[T int | float64](v Vector[T]) (r, theta float64, err error
 //	y = r * sin(θ)
 func PolarToCartesian(r, theta float64) (Vector[float64], error) {
 	if r < 0 {
-		return nil, errors.New("radius must be non-negative")
+		return nil, fmt.Errorf("radius must be non-negative: %w", ErrInvalidArgument)
 	}
 
 	x := r * math.Cos(theta)
@@ -77,17 +77,17 @@ func PolarToCartesian(r, theta float64) (Vector[float64], error) {
 //   - phi: Polar/zenith angle in radians (0 to π)
 //   - err: Error if input vector is not 3D
 func CartesianToSpherical[T int | float64](v Vector[T]) (rho, theta, phi float64, err error) {
-	if len(v) != 3 {
-		return 0, 0, 0, errors.New("vector must be 3D")
+	if err := requireDim("CartesianToSpherical", 3, len(v)); err != nil {
+		return 0, 0, 0, err
 	}
 
 	x, y, z := float64(v[0]), float64(v[1]), float64(v[2])
 
-	// Distance from origin
-	rho = math.Sqrt(x*x + y*y + z*z)
+	// Distance from origin, without underflow for tiny vectors
+	rho = math.Hypot(math.Hypot(x, y), z)
 
-	// Handle zero vector case
-	if rho < 1e-10 {
+	// Handle zero vector case; any other vector has well-defined angles
+	if rho == 0 {
 		return 0, 0, 0, nil
 	}
 
@@ -109,7 +109,7 @@ func CartesianToSpherical[T int | float64](v Vector[T]) (rho, theta, phi float64
 //
 // Returns:
 //   - Vector[float64]: 3D vector in Cartesian coordinates (x,y,z)
-//   - error: Error if radius is negative
+//   - error: Error if radius is negative (ErrInvalidArgument)
 //
 // Conversion formulas:
 //
@@ -120,7 +120,7 @@ func CartesianToSpherical[T int | float64](v Vector[T]) (rho, theta, phi float64
 // z = rho * cos(phi)
 func SphericalToCartesian(rho, theta, phi float64) (Vector[float64], error) {
 	if rho < 0 {
-		return nil, errors.New("radius must be non-negative")
+		return nil, fmt.Errorf("radius must be non-negative: %w", ErrInvalidArgument)
 	}
 
 	sinPhi := math.Sin(phi)
@@ -147,8 +147,8 @@ func SphericalToCartesian(rho, theta, phi float64) (Vector[float64], error) {
 //   - z: Height along the z-axis
 //   - err: Error if input vector is not 3D
 func CartesianToCylindrical[T int | float64](v Vector[T]) (r, theta, z float64, err error) {
-	if len(v) != 3 {
-		return 0, 0, 0, errors.New("vector must be 3D")
+	if err := requireDim("CartesianToCylindrical", 3, len(v)); err != nil {
+		return 0, 0, 0, err
 	}
 
 	x, y := float64(v[0]), float64(v[1])
@@ -172,7 +172,7 @@ func CartesianToCylindrical[T int | float64](v Vector[T]) (r, theta, z float64,
 //
 // Returns:
 //   - Vector[float64]: 3D vector in Cartesian coordinates (x,y,z)
-//   - error: Error if radial distance is negative
+//   - error: Error if radial distance is negative (ErrInvalidArgument)
 //
 // Conversion formulas:
 //
@@ -183,7 +183,7 @@ func CartesianToCylindrical[T int | float64](v Vector[T]) (r, theta, z float64,
 // z = z
 func CylindricalToCartesian(r, theta, z float64) (Vector[float64], error) {
 	if r < 0 {
-		return nil, errors.New("radial distance must be non-negative")
+		return nil, fmt.Errorf("radial distance must be non-negative: %w", ErrInvalidArgument)
 	}
 
 	x := r * math.Cos(theta)
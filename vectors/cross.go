@@ -1,7 +1,5 @@
 package vectors
 
-import "errors"
-
 // Cross calculates the cross product of two 3D vectors.
 //
 // The cross product a × b produces a vector that is perpendicular to both input vectors.
@@ -33,8 +31,8 @@ import "errors"
 //	v2 := Vector[float64]{0, 1, 0}  // Unit y-axis
 //	v3, _ := Cross(v1, v2)          // Returns [0, 0, 1] (unit z-axis)
 func Cross[T, E int | float64](a Vector[T], b Vector[E]) (Vector[float64], error) {
-	if len(a) != 3 || len(b) != 3 {
-		return nil, errors.New("cross product is only defined for 3D vectors")
+	if err := requireDim("Cross", 3, len(a), len(b)); err != nil {
+		return nil, err
 	}
 
 	return Vector[float64]{
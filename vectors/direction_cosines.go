@@ -1,6 +1,6 @@
 package vectors
 
-import "errors"
+import "fmt"
 
 // DirectionCosines calculates the direction cosines of a 3D vector.
 //
@@ -21,13 +21,13 @@ import "errors"
 // in the range [-1, 1]. They are equivalent to the components of the unit vector
 // in the same direction as the input vector.
 func DirectionCosines[T int | float64](a Vector[T]) (l, m, n float64, err error) {
-	if len(a) != 3 {
-		return 0, 0, 0, errors.New("direction cosines are only defined for 3D vectors")
+	if err := requireDim("DirectionCosines", 3, len(a)); err != nil {
+		return 0, 0, 0, err
 	}
 
 	// Direction cosines are undefined for the zero vector
 	if IsZero(a) {
-		return 0, 0, 0, errors.New("cannot calculate direction cosines for zero vector")
+		return 0, 0, 0, fmt.Errorf("cannot calculate direction cosines: %w", ErrZeroVector)
 	}
 
 	// Direction cosines are the components of the unit vector
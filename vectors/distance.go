@@ -1,9 +1,6 @@
 package vectors
 
-import (
-	"errors"
-	"math"
-)
+import "math"
 
 // EuclideanDistance calculates the Euclidean distance between two vectors.
 //
@@ -28,7 +25,7 @@ import (
 //	dist, _ := Distance(v1, v2)  // Returns √3 ≈ 1.732
 func EuclideanDistance[T, E int | float64](a Vector[T], b Vector[E]) (float64, error) {
 	if len(a) != len(b) {
-		return 0, errors.New("vectors must have the same dimension")
+		return 0, mismatch("EuclideanDistance", len(a), len(b))
 	}
 
 	var sum float64
@@ -58,7 +55,7 @@ func EuclideanDistance[T, E int | float64](a Vector[T], b Vector[E]) (float64, e
 // https://simple.wikipedia.org/wiki/Manhattan_distance
 func ManhattanDistance[T, E int | float64](a Vector[T], b Vector[E]) (float64, error) {
 	if len(a) != len(b) {
-		return 0, errors.New("vectors must have the same dimension")
+		return 0, mismatch("ManhattanDistance", len(a), len(b))
 	}
 
 	var sum float64
@@ -88,7 +85,7 @@ func ManhattanDistance[T, E int | float64](a Vector[T], b Vector[E]) (float64, e
 // https://en.wikipedia.org/wiki/Chebyshev_distance
 func ChebyshevDistance[T, E int | float64](a Vector[T], b Vector[E]) (float64, error) {
 	if len(a) != len(b) {
-		return 0, errors.New("vectors must have the same dimension")
+		return 0, mismatch("ChebyshevDistance", len(a), len(b))
 	}
 
 	var maxDiff float64
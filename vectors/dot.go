@@ -1,7 +1,5 @@
 package vectors
 
-import "errors"
-
 // Dot calculates the dot product (scalar product) of two vectors.
 //
 // The dot product a·b is defined as the sum of the products of the corresponding
@@ -30,10 +28,10 @@ import "errors"
 //	result, _ := Dot(v1, v2)  // Returns 32.0 = 1*4 + 2*5 + 3*6
 func Dot[T, E int | float64](a Vector[T], b Vector[E]) (result float64, err error) {
 	if len(a) != len(b) {
-		return 0, errors.New("vectors must have the same dimension")
+		return 0, mismatch("Dot", len(a), len(b))
 	}
 	if len(a) == 0 {
-		return 0, errors.New("vectors cannot be empty")
+		return 0, ErrEmpty
 	}
 
 	for i := range a {
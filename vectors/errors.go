@@ -0,0 +1,73 @@
+package vectors
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ErrEmpty is returned when an operation requires a vector with at least one
+// component and the input has none.
+var ErrEmpty = errors.New("vector is empty")
+
+// ErrZeroVector is returned when an operation needs a direction, such as
+// Normalize or Angle, and the input is the zero vector.
+var ErrZeroVector = errors.New("zero vector has no direction")
+
+// ErrDimensionMismatch is matched by DimensionError via errors.Is.
+var ErrDimensionMismatch = errors.New("incompatible dimensions")
+
+// ErrInvalidArgument is returned when a scalar argument is outside the range an
+// operation accepts, such as an out-of-bounds index or a negative radius.
+var ErrInvalidArgument = errors.New("invalid argument")
+
+// DimensionError reports vectors whose dimensions are incompatible for an
+// operation: they either differ from each other or, for operations such as
+// Cross that are only defined in a fixed dimension, from the one required.
+//
+// Use errors.Is(err, ErrDimensionMismatch) to test for it, or errors.As to
+// inspect the dimensions.
+type DimensionError struct {
+	Op   string // Operation that rejected the operands, such as "Dot"
+	Dims []int  // Dimension of each operand, in argument order
+	Want int    // Required dimension, or 0 when the operands only need to agree
+}
+
+func (e *DimensionError) Error() string {
+	dims := make([]string, len(e.Dims))
+	for i, d := range e.Dims {
+		dims[i] = strconv.Itoa(d)
+	}
+	got := strings.Join(dims, ", ")
+
+	if e.Want > 0 {
+		if len(dims) == 1 {
+			return fmt.Sprintf("%s requires a %dD vector: got dimension %s", e.Op, e.Want, got)
+		}
+		return fmt.Sprintf("%s requires %dD vectors: got dimensions %s", e.Op, e.Want, got)
+	}
+	return fmt.Sprintf("%s requires vectors of the same dimension: got %s", e.Op, got)
+}
+
+// Unwrap returns ErrDimensionMismatch so that errors.Is recognizes the error.
+func (e *DimensionError) Unwrap() error {
+	return ErrDimensionMismatch
+}
+
+// mismatch returns a DimensionError for op, whose operands must all have the
+// same dimension.
+func mismatch(op string, dims ...int) error {
+	return &DimensionError{Op: op, Dims: dims}
+}
+
+// requireDim returns a DimensionError for op if any of dims differs from want,
+// and nil otherwise.
+func requireDim(op string, want int, dims ...int) error {
+	for _, d := range dims {
+		if d != want {
+			return &DimensionError{Op: op, Dims: dims, Want: want}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+package vectors
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestErrors_Sentinels(t *testing.T) {
+	v2 := Vector[int]{1, 2}
+	v3 := Vector[int]{1, 2, 3}
+	zero := Vector[int]{0, 0, 0}
+
+	tests := []struct {
+		name   string
+		call   func() error
+		target error
+	}{
+		{"Dot mismatch", func() error { _, err := Dot(v2, v3); return err }, ErrDimensionMismatch},
+		{"Dot empty", func() error { _, err := Dot(Vector[int]{}, Vector[int]{}); return err }, ErrEmpty},
+		{"Add mismatch", func() error { _, err := Add(v2, v3); return err }, ErrDimensionMismatch},
+		{"EuclideanDistance mismatch", func() error { _, err := EuclideanDistance(v3, v2); return err }, ErrDimensionMismatch},
+		{"Cross not 3D", func() error { _, err := Cross(v2, v2); return err }, ErrDimensionMismatch},
+		{"ScalarProduct not 3D", func() error { _, err := ScalarProduct(v3, v3, v2); return err }, ErrDimensionMismatch},
+		{"CartesianToPolar not 2D", func() error { _, _, err := CartesianToPolar(v3); return err }, ErrDimensionMismatch},
+		{"Rotate3D not 3D", func() error { _, err := Rotate3D(v2, v3, 1); return err }, ErrDimensionMismatch},
+		{"Normalize zero", func() error { _, err := Normalize(zero); return err }, ErrZeroVector},
+		{"Angle zero", func() error { _, err := Angle(v3, zero); return err }, ErrZeroVector},
+		{"Project onto zero", func() error { _, err := Project(v3, zero); return err }, ErrZeroVector},
+		{"DirectionCosines zero", func() error { _, _, _, err := DirectionCosines(zero); return err }, ErrZeroVector},
+		{"IsParallel zero", func() error { _, err := IsParallel(zero, v3); return err }, ErrZeroVector},
+		{"IsParallel mismatch", func() error { _, err := IsParallel(v2, v3); return err }, ErrDimensionMismatch},
+		{"StandardBasis zero dimension", func() error { _, err := StandardBasis(0); return err }, ErrInvalidArgument},
+		{"StandardBasisVector axis out of bounds", func() error { _, err := StandardBasisVector(3, 3); return err }, ErrInvalidArgument},
+		{"Set out of bounds", func() error { return v3.Set(3, 0) }, ErrInvalidArgument},
+		{"Get out of bounds", func() error { _, err := v3.Get(-1); return err }, ErrInvalidArgument},
+		{"PolarToCartesian negative radius", func() error { _, err := PolarToCartesian(-1, 0); return err }, ErrInvalidArgument},
+		{"SphericalToCartesian negative radius", func() error { _, err := SphericalToCartesian(-1, 0, 0); return err }, ErrInvalidArgument},
+		{"CylindricalToCartesian negative radius", func() error { _, err := CylindricalToCartesian(-1, 0, 0); return err }, ErrInvalidArgument},
+		{"Rotate3D non-unit axis", func() error { _, err := Rotate3D(v3, v3, 1); return err }, ErrInvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.call(); !errors.Is(err, tt.target) {
+				t.Errorf("expected %v, got %v", tt.target, err)
+			}
+		})
+	}
+}
+
+func TestDimensionError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want DimensionError
+		msg  string
+	}{
+		{
+			name: "Same dimension",
+			err:  func() error { _, err := Dot(Vector[int]{1, 2}, Vector[int]{1, 2, 3}); return err }(),
+			want: DimensionError{Op: "Dot", Dims: []int{2, 3}},
+			msg:  "Dot requires vectors of the same dimension: got 2, 3",
+		},
+		{
+			name: "Fixed dimension",
+			err:  func() error { _, err := Cross(Vector[int]{1, 2, 3}, Vector[int]{1, 2}); return err }(),
+			want: DimensionError{Op: "Cross", Dims: []int{3, 2}, Want: 3},
+			msg:  "Cross requires 3D vectors: got dimensions 3, 2",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var dimErr *DimensionError
+			if !errors.As(tt.err, &dimErr) {
+				t.Fatalf("expected *DimensionError, got %T: %v", tt.err, tt.err)
+			}
+			if dimErr.Op != tt.want.Op || dimErr.Want != tt.want.Want || !slices.Equal(dimErr.Dims, tt.want.Dims) {
+				t.Errorf("expected %+v, got %+v", tt.want, *dimErr)
+			}
+			if got := tt.err.Error(); got != tt.msg {
+				t.Errorf("expected message %q, got %q", tt.msg, got)
+			}
+		})
+	}
+}
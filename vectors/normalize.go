@@ -1,6 +1,6 @@
 package vectors
 
-import "errors"
+import "fmt"
 
 // Normalize returns the unit vector (vector of length 1) in the direction of the input vector.
 //
@@ -29,11 +29,11 @@ import "errors"
 //	unit, _ := Normalize(v)  // Returns [0.6, 0.8]
 func Normalize[T int | float64](a Vector[T]) (Vector[float64], error) {
 	if IsZero(a) {
-		return nil, errors.New("cannot normalize zero vector")
+		return nil, fmt.Errorf("cannot normalize: %w", ErrZeroVector)
 	}
 	mag := Magnitude(a)
 	if mag == 0 {
-		return nil, errors.New("cannot normalize zero vector")
+		return nil, fmt.Errorf("cannot normalize: %w", ErrZeroVector)
 	}
 	result := make(Vector[float64], len(a))
 	for i, v := range a {
@@ -1,7 +1,5 @@
 package vectors
 
-import "errors"
-
 // ScalarProduct calculates the scalar triple product of three vectors (A • (B × C)).
 //
 // The scalar triple product represents the signed volume of the parallelepiped
@@ -35,8 +33,8 @@ import "errors"
 //	vol, _ := ScalarProduct(v1, v2, v3) // Returns 1.0 (unit cube volume)
 func ScalarProduct[T, E, B int | float64](a Vector[T], b Vector[E], c Vector[B]) (float64, error) {
 	// All vectors must be 3D for cross product
-	if len(a) != 3 || len(b) != 3 || len(c) != 3 {
-		return 0, errors.New("scalar triple product requires three 3D vectors")
+	if err := requireDim("ScalarProduct", 3, len(a), len(b), len(c)); err != nil {
+		return 0, err
 	}
 
 	cross, err := Cross(b, c)
@@ -72,8 +70,8 @@ func ScalarProduct[T, E, B int | float64](a Vector[T], b Vector[E], c Vector[B])
 //	result, _ := VectorProduct(v1, v2, v3) // Returns [0, -1, 0]
 func VectorProduct[T, E, B int | float64](a Vector[T], b Vector[E], c Vector[B]) (Vector[float64], error) {
 	// All vectors must be 3D for cross product
-	if len(a) != 3 || len(b) != 3 || len(c) != 3 {
-		return nil, errors.New("vector triple product requires three 3D vectors")
+	if err := requireDim("VectorProduct", 3, len(a), len(b), len(c)); err != nil {
+		return nil, err
 	}
 
 	// BAC-CAB identity: A × (B × C) = B(A•C) - C(A•B)
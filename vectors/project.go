@@ -1,6 +1,6 @@
 package vectors
 
-import "errors"
+import "fmt"
 
 // Project calculates the vector projection of vector a onto vector b.
 //
@@ -30,14 +30,14 @@ import "errors"
 //	proj, _ := Project(v1, v2)  // Returns [3.0, 0.0] (projection onto x-axis)
 func Project[T, E int | float64](a Vector[T], b Vector[E]) (Vector[float64], error) {
 	if len(a) != len(b) {
-		return nil, errors.New("vectors must have the same dimension")
+		return nil, mismatch("Project", len(a), len(b))
 	}
 	if len(a) == 0 {
-		return nil, errors.New("vectors cannot be empty")
+		return nil, ErrEmpty
 	}
 
 	if IsZero(b) {
-		return nil, errors.New("cannot project onto zero vector")
+		return nil, fmt.Errorf("cannot project onto the zero vector: %w", ErrZeroVector)
 	}
 
 	dot, err := Dot(a, b)
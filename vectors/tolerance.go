@@ -0,0 +1,29 @@
+package vectors
+
+import "github.com/rickykimani/linalg/internal/negligible"
+
+// machEps is the machine epsilon for float64, the gap between 1 and the next
+// representable number.
+const machEps = 0x1p-52
+
+// Tolerance decides how close a computed quantity must be to its ideal value
+// for a predicate such as IsUnit or IsOrthogonal to hold.
+//
+// The quantity may deviate by at most
+//
+//	max(Abs, Rel·scale)
+//
+// where each predicate documents its scale. Predicates compare scale-free
+// quantities such as the cosine of an angle, so that the answer does not
+// change when the vectors are scaled: vectors with 1e-8-sized components are
+// orthogonal exactly when the same vectors scaled by 1e8 are.
+//
+// The zero Tolerance selects the default documented by each predicate, which
+// is derived from the machine epsilon ε ≈ 2.2e-16. The matrix package uses the
+// same type for its own thresholds.
+//
+// Example:
+//
+//	IsUnit(v)                          // Default tolerance
+//	IsUnit(v, Tolerance{Rel: 1e-6})    // Accept a rounded unit vector
+type Tolerance = negligible.Tolerance
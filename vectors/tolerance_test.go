@@ -0,0 +1,86 @@
+package vectors
+
+import (
+	"math"
+	"testing"
+)
+
+func TestPredicates_ScaleInvariance(t *testing.T) {
+	// Small vectors that are clearly not orthogonal used to pass the absolute
+	// 1e-10 check on their dot product
+	for _, s := range []float64{1e-8, 1, 1e8} {
+		a := Vector[float64]{s, 0, 0}
+		b := Vector[float64]{s, s, 0}
+		c := Vector[float64]{0, 0, s}
+
+		if ok, _ := IsOrthogonal(a, b); ok {
+			t.Errorf("scale %g: vectors at 45° reported orthogonal", s)
+		}
+		if ok, _ := IsOrthogonal(a, c); !ok {
+			t.Errorf("scale %g: perpendicular vectors not reported orthogonal", s)
+		}
+		if ok, _ := IsParallel(a, Vector[float64]{-3 * s, 0, 0}); !ok {
+			t.Errorf("scale %g: anti-parallel vectors not reported parallel", s)
+		}
+		if ok, _ := IsParallel(a, b); ok {
+			t.Errorf("scale %g: vectors at 45° reported parallel", s)
+		}
+	}
+}
+
+func TestPredicates_Tolerance(t *testing.T) {
+	// The default accepts angles below about 1.4e-5 radians
+	a := Vector[float64]{1, 0}
+	for _, tt := range []struct {
+		angle    float64
+		parallel bool
+	}{{1e-6, true}, {1.4e-5, true}, {1.5e-5, false}, {1e-4, false}} {
+		b := Vector[float64]{math.Cos(tt.angle), math.Sin(tt.angle)}
+		if ok, _ := IsParallel(a, b); ok != tt.parallel {
+			t.Errorf("vectors %g rad apart: parallel = %v by default, expected %v", tt.angle, ok, tt.parallel)
+		}
+	}
+
+	// Nearly parallel: angle of 1e-6 radians
+	b := Vector[float64]{math.Cos(1e-6), math.Sin(1e-6)}
+	if ok, _ := IsParallel(a, b, Tolerance{Rel: 1e-8}); ok {
+		t.Error("expected vectors 1e-6 rad apart not to be parallel with tolerance 1e-8")
+	}
+	if ok, _ := IsParallel(a, b, Tolerance{Rel: 1e-5}); !ok {
+		t.Error("expected vectors 1e-6 rad apart to be parallel with tolerance 1e-5")
+	}
+
+	// Nearly orthogonal
+	c := Vector[float64]{math.Sin(1e-6), math.Cos(1e-6)}
+	if ok, _ := IsOrthogonal(a, c); ok {
+		t.Error("expected vectors 1e-6 rad from perpendicular not to be orthogonal by default")
+	}
+	if ok, _ := IsOrthogonal(a, c, Tolerance{Abs: 1e-5}); !ok {
+		t.Error("expected vectors 1e-6 rad from perpendicular to be orthogonal with tolerance 1e-5")
+	}
+
+	// A hand-rounded unit vector
+	r := Vector[float64]{0.7071068, 0.7071068}
+	if IsUnit(r) {
+		t.Error("rounded vector should not be a unit vector at the default tolerance")
+	}
+	if !IsUnit(r, Tolerance{Rel: 1e-6}) {
+		t.Error("rounded vector should be a unit vector at tolerance 1e-6")
+	}
+
+	// The default accepts deviations below 1e-10
+	if !IsUnit(Vector[float64]{1 + 5e-11, 0}) {
+		t.Error("vector 5e-11 from unit length should be a unit vector by default")
+	}
+	if IsUnit(Vector[float64]{1 + 5e-11, 0}, Tolerance{Abs: 1e-12}) {
+		t.Error("vector 5e-11 from unit length should not be a unit vector at tolerance 1e-12")
+	}
+
+	// Normalized vectors are unit at the default tolerance
+	for _, v := range []Vector[float64]{{3, 4}, {1, 1, 1}, {1e-9, 2e-9, -3e-9}, {1e9, 7, -2e9, 5e8}} {
+		u, _ := Normalize(v)
+		if !IsUnit(u) {
+			t.Errorf("Normalize(%v) = %v is not a unit vector", v, u)
+		}
+	}
+}
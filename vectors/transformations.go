@@ -1,7 +1,7 @@
 package vectors
 
 import (
-	"errors"
+	"fmt"
 	"math"
 )
 
@@ -48,8 +48,8 @@ func Reflect[T, E int | float64](v Vector[T], n Vector[E]) (Vector[float64], err
 //
 // Time complexity: O(1) - constant time regardless of vector size
 func Rotate2D[T int | float64](v Vector[T], angle float64) (Vector[float64], error) {
-	if len(v) != 2 {
-		return nil, errors.New("vector must be 2D")
+	if err := requireDim("Rotate2D", 2, len(v)); err != nil {
+		return nil, err
 	}
 
 	sin, cos := math.Sin(angle), math.Cos(angle)
@@ -72,14 +72,15 @@ func Rotate2D[T int | float64](v Vector[T], angle float64) (Vector[float64], err
 // Returns:
 //   - Vector[float64]: The rotated vector
 //   - error: An error if the vector is not 3D or if the axis is not a unit vector
+//     (ErrInvalidArgument)
 func Rotate3D[T, E int | float64](v Vector[T], axis Vector[E], angle float64) (Vector[float64], error) {
-	if len(v) != 3 || len(axis) != 3 {
-		return nil, errors.New("both vectors must be 3D")
+	if err := requireDim("Rotate3D", 3, len(v), len(axis)); err != nil {
+		return nil, err
 	}
 
 	// Check if axis is approximately a unit vector
 	if !IsUnit(axis) {
-		return nil, errors.New("axis must be a unit vector")
+		return nil, fmt.Errorf("axis must be a unit vector: %w", ErrInvalidArgument)
 	}
 
 	// Rodrigues' rotation formula:
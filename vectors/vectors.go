@@ -7,9 +7,10 @@
 package vectors
 
 import (
-	"errors"
 	"fmt"
 	"math"
+
+	"github.com/rickykimani/linalg/internal/negligible"
 )
 
 // Vector represents a mathematical vector as a slice of numeric values.
@@ -37,14 +38,14 @@ var UnitZ = Vector[float64]{0, 0, 1}
 //
 // Returns:
 //   - []Vector[float64]: A slice containing all basis vectors, essentially an identity matrix of order dim
-//   - error: Error if dimension is invalid
+//   - error: Error if dimension is invalid (ErrInvalidArgument)
 //
 // Example:
 //
 //	basis, _ := StandardBasis(3) // Returns [[1,0,0], [0,1,0], [0,0,1]]
 func StandardBasis(dim int) ([]Vector[float64], error) {
 	if dim <= 0 {
-		return nil, errors.New("dimension must be positive")
+		return nil, fmt.Errorf("dimension must be positive: %w", ErrInvalidArgument)
 	}
 	basis := make([]Vector[float64], dim)
 	for i := range dim {
@@ -62,7 +63,7 @@ func StandardBasis(dim int) ([]Vector[float64], error) {
 //
 // Returns:
 //   - Vector[float64]: A unit vector along the specified axis
-//   - error: Error if axis is out of bounds or dimension is invalid
+//   - error: Error if axis is out of bounds or dimension is invalid (ErrInvalidArgument)
 //
 // Example:
 //
@@ -70,11 +71,11 @@ func StandardBasis(dim int) ([]Vector[float64], error) {
 //	yAxis, _ := StandardBasisVector(1, 4) // Returns [0,1,0,0] in 4D space
 func StandardBasisVector(axis, dim int) (Vector[float64], error) {
 	if dim <= 0 {
-		return nil, errors.New("dimension must be positive")
+		return nil, fmt.Errorf("dimension must be positive: %w", ErrInvalidArgument)
 	}
 
 	if axis < 0 || axis >= dim {
-		return nil, fmt.Errorf("axis %d out of bounds for dimension %d", axis, dim)
+		return nil, fmt.Errorf("axis %d out of bounds for dimension %d: %w", axis, dim, ErrInvalidArgument)
 	}
 
 	v := make(Vector[float64], dim)
@@ -139,7 +140,7 @@ func NewEmptyVector(size int) Vector[float64] {
 //   - val: The new value to set at the specified index
 //
 // Returns:
-//   - error: An error if the index is out of bounds
+//   - error: An error if the index is out of bounds (ErrInvalidArgument)
 //
 // Example:
 //
@@ -147,7 +148,7 @@ func NewEmptyVector(size int) Vector[float64] {
 //	err := vec.Set(1, 5.0)  // vec becomes {1.0, 5.0, 3.0}
 func (v *Vector[T]) Set(i int, val T) error {
 	if i < 0 || i >= len(*v) {
-		return fmt.Errorf("index %d out of bounds for vector of length %d", i, len(*v))
+		return fmt.Errorf("index %d out of bounds for vector of length %d: %w", i, len(*v), ErrInvalidArgument)
 	}
 	(*v)[i] = val
 	return nil
@@ -164,7 +165,7 @@ func (v *Vector[T]) Set(i int, val T) error {
 //
 // Returns:
 //   - T: The value at the specified index
-//   - error: An error if the index is out of bounds
+//   - error: An error if the index is out of bounds (ErrInvalidArgument)
 //
 // Example:
 //
@@ -173,7 +174,7 @@ func (v *Vector[T]) Set(i int, val T) error {
 func (v *Vector[T]) Get(i int) (T, error) {
 	var zero T
 	if i < 0 || i >= len(*v) {
-		return zero, fmt.Errorf("index %d out of bounds for vector of length %d", i, len(*v))
+		return zero, fmt.Errorf("index %d out of bounds for vector of length %d: %w", i, len(*v), ErrInvalidArgument)
 	}
 	return (*v)[i], nil
 }
@@ -214,33 +215,43 @@ func IsZero[T int | float64](v Vector[T]) bool {
 // IsUnit checks if a vector has magnitude approximately equal to 1.
 //
 // Unit vectors are important in many applications as they represent
-// pure direction without magnitude. The function uses a small epsilon
-// value to account for floating-point precision errors.
+// pure direction without magnitude.
+//
+// Parameters:
+//   - v: The vector to check
+//   - tol: Optional tolerance on |‖v‖ - 1| (default 1e-10, which accepts the
+//     output of Normalize and hand-entered vectors with about ten correct
+//     digits)
 //
 // Returns:
 //   - bool: true if the vector's magnitude is approximately 1.0, false otherwise
-func IsUnit[T int | float64](v Vector[T]) bool {
-	return math.Abs(Magnitude(v)-1.0) < 1e-10
+func IsUnit[T int | float64](v Vector[T], tol ...Tolerance) bool {
+	return math.Abs(Magnitude(v)-1.0) <= negligible.Threshold(tol, 1e-10, 1)
 }
 
 // IsOrthogonal checks if two vectors are perpendicular (orthogonal) to each other.
 //
-// Two vectors are orthogonal if their dot product is zero. The function uses a small
-// epsilon value to account for floating-point precision errors.
+// Two vectors are orthogonal if their dot product is zero. The check is
+// relative: |a·b| ≤ tol·‖a‖·‖b‖, that is the cosine of the angle between them
+// is within the tolerance of zero.
 //
 // Parameters:
 //   - a: First vector
 //   - b: Second vector
+//   - tol: Optional tolerance on |cos θ| (default √ε ≈ 1.5e-8, which allows
+//     for the cancellation in computing orthogonal vectors)
 //
 // Returns:
 //   - bool: true if the vectors are orthogonal, false otherwise
 //   - error: An error if the vectors have incompatible dimensions
-func IsOrthogonal[T, E int | float64](a Vector[T], b Vector[E]) (bool, error) {
+//
+// A zero vector is orthogonal to every vector.
+func IsOrthogonal[T, E int | float64](a Vector[T], b Vector[E], tol ...Tolerance) (bool, error) {
 	dot, err := Dot(a, b)
 	if err != nil {
 		return false, fmt.Errorf("checking orthogonality: %w", err)
 	}
-	return math.Abs(dot) < 1e-10, nil
+	return math.Abs(dot) <= negligible.Threshold(tol, math.Sqrt(machEps), Magnitude(a)*Magnitude(b)), nil
 }
 
 // IsParallel checks if two vectors are parallel or anti-parallel.
@@ -251,17 +262,23 @@ func IsOrthogonal[T, E int | float64](a Vector[T], b Vector[E]) (bool, error) {
 // Parameters:
 //   - a: First vector
 //   - b: Second vector
+//   - tol: Optional tolerance on |sin θ| (default √(2·10⁻¹⁰) ≈ 1.4e-5, which
+//     accepts the same angles as the check 1 - |cos θ| < 1e-10)
 //
 // Returns:
 //   - bool: true if vectors are parallel, false otherwise
 //   - error: An error if the vectors have incompatible dimensions or if either is a zero vector
-func IsParallel[T, E int | float64](a Vector[T], b Vector[E]) (bool, error) {
+//
+// The sine of the angle is computed as the length of the component of b̂
+// perpendicular to â, which unlike 1 - |cos θ| stays accurate for nearly
+// parallel vectors.
+func IsParallel[T, E int | float64](a Vector[T], b Vector[E], tol ...Tolerance) (bool, error) {
 	if IsZero(a) || IsZero(b) {
-		return false, errors.New("zero vectors have no defined direction")
+		return false, fmt.Errorf("checking parallelism: %w", ErrZeroVector)
 	}
 
 	if len(a) != len(b) {
-		return false, fmt.Errorf("vectors must have same dimension: got %d and %d", len(a), len(b))
+		return false, mismatch("IsParallel", len(a), len(b))
 	}
 
 	// Normalize both vectors to compare directions
@@ -275,9 +292,13 @@ func IsParallel[T, E int | float64](a Vector[T], b Vector[E]) (bool, error) {
 		return false, err
 	}
 
-	// Check if normalized vectors are equal or negatives of each other
-	dotProduct, _ := Dot(aNorm, bNorm)
-	return math.Abs(math.Abs(dotProduct)-1.0) < 1e-10, nil
+	// |sin θ| = ‖b̂ - (â·b̂)·â‖
+	cos, _ := Dot(aNorm, bNorm)
+	sin := 0.0
+	for i := range bNorm {
+		sin = math.Hypot(sin, bNorm[i]-cos*aNorm[i])
+	}
+	return sin <= negligible.Threshold(tol, math.Sqrt(2e-10), 1), nil
 }
 
 // Copy creates a deep copy of the vector, converting it to Vector[float64].
@@ -309,7 +330,7 @@ func (v Vector[T]) Copy() Vector[float64] {
 
 func vectorsAlmostEqual[T, E int | float64](a Vector[T], b Vector[E]) (bool, error) {
 	if len(a) != len(b) {
-		return false, errors.New("vectors must have the same dimension")
+		return false, mismatch("vectorsAlmostEqual", len(a), len(b))
 	}
 
 	const epsilon = 1e-6